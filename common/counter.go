@@ -47,6 +47,10 @@ func (m *Counter) Increment() {
 	atomic.AddUint64(&m.curr, 1)
 }
 
+func (m *Counter) Add(n uint64) {
+	atomic.AddUint64(&m.curr, n)
+}
+
 func (m *Counter) Flush() uint64 {
 	old := atomic.SwapUint64(&m.curr, 0)
 	atomic.AddUint64(&m.total, old)
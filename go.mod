@@ -5,5 +5,9 @@ go 1.22.2
 require (
 	github.com/bytedance/gopkg v0.0.0-20240315062850-21fc7a1671a8
 	github.com/golang-jwt/jwt v3.2.2+incompatible
+	golang.org/x/crypto v0.22.0
+	golang.org/x/net v0.24.0
 	google.golang.org/protobuf v1.33.0
 )
+
+require golang.org/x/sys v0.19.0 // indirect
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
 	"github.com/pemmel/gameserver/server/auth"
 	"github.com/pemmel/gameserver/server/game"
 )
@@ -23,8 +25,16 @@ func main() {
 
 	gamePort, _ := strconv.Atoi(os.Getenv("GAME_PORT"))
 	authAddress := fmt.Sprintf("0.0.0.0:%s", os.Getenv("AUTH_PORT"))
+	jwksFile := os.Getenv("JWKS_FILE")
+	if jwksFile == "" {
+		fmt.Println("JWKS_FILE is not set, point it to the JWKS file holding the token verification keys, see jwks.example.md")
+		return
+	}
 
-	err = game.RunGameServer(game.Config{
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err = game.RunGameServer(ctx, game.Config{
 		Address: &net.UDPAddr{
 			IP:   net.ParseIP("0.0.0.0"),
 			Port: gamePort,
@@ -32,32 +42,51 @@ func main() {
 		QueueCapacity:   1e6,
 		QueueBufferSize: 1500,
 		NbWorkers:       ncpu,
+		// Friendships is left unset until a friend list backend is available, so
+		// friends-of-members lobbies reject every join request.
 	})
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	err = auth.RunAuthServer(auth.Config{
-		Address:         authAddress,
-		TlsCertFile:     "ca-cert.pem",
-		TlsKeyFile:      "ca-key.pem",
-		QueueCapacity:   100,
-		QueueBufferSize: 1024,
-		NbWorkers:       ncpu,
-		ReadTimeout:     3 * time.Second,
-		WriteTimeout:    2 * time.Second,
+	err = auth.RunAuthServer(ctx, auth.Config{
+		Address:            authAddress,
+		TlsCertFile:        "ca-cert.pem",
+		TlsKeyFile:         "ca-key.pem",
+		JwksFile:           jwksFile,
+		JwksReloadInterval: 10 * time.Second,
+		QueueCapacity:      100,
+		MaxRequestSize:     8192,
+		NbWorkers:          ncpu,
+		ReadTimeout:        3 * time.Second,
+		WriteTimeout:       2 * time.Second,
+		Verifiers: []auth.IdentityVerifier{
+			auth.AnonymousVerifier{},
+			auth.SteamVerifier{AppId: "480"},
+		},
+		ConflictPolicy: auth.ConflictResume,
+		OnKick:         game.Disconnect,
+		OnResume:       game.Reconnect,
 	})
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
+	server.SharedSession().RunReaper(ctx, server.ReaperConfig{
+		Interval:    5 * time.Second,
+		IdleTimeout: 30 * time.Second,
+		MaxLifetime: 24 * time.Hour,
+		OnExpire:    game.Expired,
+	})
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 	signal.Notify(c, syscall.SIGABRT)
 	go func() {
 		<-c
+		cancel()
 		os.Exit(0)
 	}()
 
@@ -25,8 +25,10 @@ type AuthResponseLoginSuccess struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sidx      uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
-	Aes256Key []byte `protobuf:"bytes,2,opt,name=aes256key,proto3" json:"aes256key,omitempty"`
+	Sidx            uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+	Aes256Key       []byte `protobuf:"bytes,2,opt,name=aes256key,proto3" json:"aes256key,omitempty"`
+	X25519PublicKey []byte `protobuf:"bytes,3,opt,name=x25519_public_key,json=x25519PublicKey,proto3" json:"x25519_public_key,omitempty"`
+	ReconnectToken  []byte `protobuf:"bytes,4,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
 }
 
 func (x *AuthResponseLoginSuccess) Reset() {
@@ -75,18 +77,38 @@ func (x *AuthResponseLoginSuccess) GetAes256Key() []byte {
 	return nil
 }
 
+func (x *AuthResponseLoginSuccess) GetX25519PublicKey() []byte {
+	if x != nil {
+		return x.X25519PublicKey
+	}
+	return nil
+}
+
+func (x *AuthResponseLoginSuccess) GetReconnectToken() []byte {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return nil
+}
+
 var File_protobuf_auth_response_proto protoreflect.FileDescriptor
 
 var file_protobuf_auth_response_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f,
 	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x22, 0x4c, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x65, 0x73, 0x32,
-	0x35, 0x36, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x65, 0x73,
-	0x32, 0x35, 0x36, 0x6b, 0x65, 0x79, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x65, 0x73,
+	0x32, 0x35, 0x36, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x65,
+	0x73, 0x32, 0x35, 0x36, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x78, 0x32, 0x35, 0x35, 0x31,
+	0x39, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x78, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x5a, 0x0a,
+	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -19,22 +20,1437 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SyncPosRequest carries the latest movement state of the client player.
+// timestamp is the client clock in milliseconds when the state was sampled.
+// snapshot_ack is the sequence of the latest snapshot received by the client,
+// used by the server as the baseline of the next delta snapshots.
+type SyncPosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position    *Vector3    `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation    *Quaternion `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Velocity    *Vector3    `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Timestamp   uint32      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SnapshotAck uint32      `protobuf:"varint,5,opt,name=snapshot_ack,json=snapshotAck,proto3" json:"snapshot_ack,omitempty"`
+}
+
+func (x *SyncPosRequest) Reset() {
+	*x = SyncPosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncPosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPosRequest) ProtoMessage() {}
+
+func (x *SyncPosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPosRequest.ProtoReflect.Descriptor instead.
+func (*SyncPosRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyncPosRequest) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *SyncPosRequest) GetRotation() *Quaternion {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *SyncPosRequest) GetVelocity() *Vector3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *SyncPosRequest) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SyncPosRequest) GetSnapshotAck() uint32 {
+	if x != nil {
+		return x.SnapshotAck
+	}
+	return 0
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{1}
+}
+
+type CreateLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *CreateLobbyRequest) Reset() {
+	*x = CreateLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobbyRequest) ProtoMessage() {}
+
+func (x *CreateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*CreateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateLobbyRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type InviteLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteeSidx uint32 `protobuf:"varint,1,opt,name=invitee_sidx,json=inviteeSidx,proto3" json:"invitee_sidx,omitempty"`
+}
+
+func (x *InviteLobbyRequest) Reset() {
+	*x = InviteLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteLobbyRequest) ProtoMessage() {}
+
+func (x *InviteLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteLobbyRequest.ProtoReflect.Descriptor instead.
+func (*InviteLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InviteLobbyRequest) GetInviteeSidx() uint32 {
+	if x != nil {
+		return x.InviteeSidx
+	}
+	return 0
+}
+
+type LeaveLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveLobbyRequest) Reset() {
+	*x = LeaveLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveLobbyRequest) ProtoMessage() {}
+
+func (x *LeaveLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveLobbyRequest.ProtoReflect.Descriptor instead.
+func (*LeaveLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{4}
+}
+
+type AcceptLobbyInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitorSidx uint32 `protobuf:"varint,1,opt,name=invitor_sidx,json=invitorSidx,proto3" json:"invitor_sidx,omitempty"`
+	Accept      bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *AcceptLobbyInvitesRequest) Reset() {
+	*x = AcceptLobbyInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptLobbyInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptLobbyInvitesRequest) ProtoMessage() {}
+
+func (x *AcceptLobbyInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptLobbyInvitesRequest.ProtoReflect.Descriptor instead.
+func (*AcceptLobbyInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AcceptLobbyInvitesRequest) GetInvitorSidx() uint32 {
+	if x != nil {
+		return x.InvitorSidx
+	}
+	return 0
+}
+
+func (x *AcceptLobbyInvitesRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+// RequestJoinLobbyRequest asks the host of the lobby which lobby_sidx belongs
+// to for permission to join it.
+type RequestJoinLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbySidx uint32 `protobuf:"varint,1,opt,name=lobby_sidx,json=lobbySidx,proto3" json:"lobby_sidx,omitempty"`
+}
+
+func (x *RequestJoinLobbyRequest) Reset() {
+	*x = RequestJoinLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestJoinLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestJoinLobbyRequest) ProtoMessage() {}
+
+func (x *RequestJoinLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestJoinLobbyRequest.ProtoReflect.Descriptor instead.
+func (*RequestJoinLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestJoinLobbyRequest) GetLobbySidx() uint32 {
+	if x != nil {
+		return x.LobbySidx
+	}
+	return 0
+}
+
+type RespondJoinLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequesterSidx uint32 `protobuf:"varint,1,opt,name=requester_sidx,json=requesterSidx,proto3" json:"requester_sidx,omitempty"`
+	Accept        bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondJoinLobbyRequest) Reset() {
+	*x = RespondJoinLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondJoinLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondJoinLobbyRequest) ProtoMessage() {}
+
+func (x *RespondJoinLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondJoinLobbyRequest.ProtoReflect.Descriptor instead.
+func (*RespondJoinLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RespondJoinLobbyRequest) GetRequesterSidx() uint32 {
+	if x != nil {
+		return x.RequesterSidx
+	}
+	return 0
+}
+
+func (x *RespondJoinLobbyRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type DismissLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DismissLobbyRequest) Reset() {
+	*x = DismissLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissLobbyRequest) ProtoMessage() {}
+
+func (x *DismissLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissLobbyRequest.ProtoReflect.Descriptor instead.
+func (*DismissLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{8}
+}
+
+type SetLobbyModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *SetLobbyModeRequest) Reset() {
+	*x = SetLobbyModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyModeRequest) ProtoMessage() {}
+
+func (x *SetLobbyModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyModeRequest.ProtoReflect.Descriptor instead.
+func (*SetLobbyModeRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetLobbyModeRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type SetLobbyHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostSidx uint32 `protobuf:"varint,1,opt,name=host_sidx,json=hostSidx,proto3" json:"host_sidx,omitempty"`
+}
+
+func (x *SetLobbyHostRequest) Reset() {
+	*x = SetLobbyHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyHostRequest) ProtoMessage() {}
+
+func (x *SetLobbyHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyHostRequest.ProtoReflect.Descriptor instead.
+func (*SetLobbyHostRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetLobbyHostRequest) GetHostSidx() uint32 {
+	if x != nil {
+		return x.HostSidx
+	}
+	return 0
+}
+
+type SetLobbyReadyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *SetLobbyReadyRequest) Reset() {
+	*x = SetLobbyReadyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyReadyRequest) ProtoMessage() {}
+
+func (x *SetLobbyReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyReadyRequest.ProtoReflect.Descriptor instead.
+func (*SetLobbyReadyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetLobbyReadyRequest) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type KickLobbyPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+}
+
+func (x *KickLobbyPlayerRequest) Reset() {
+	*x = KickLobbyPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickLobbyPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickLobbyPlayerRequest) ProtoMessage() {}
+
+func (x *KickLobbyPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickLobbyPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickLobbyPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KickLobbyPlayerRequest) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+// ListLobbyInvitationsRequest asks for the pending invitations of the player,
+// answered with LobbyInvitationsResponse.
+type ListLobbyInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLobbyInvitationsRequest) Reset() {
+	*x = ListLobbyInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLobbyInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLobbyInvitationsRequest) ProtoMessage() {}
+
+func (x *ListLobbyInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLobbyInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListLobbyInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{13}
+}
+
+type SetLobbyPrivacyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Privacy LobbyPrivacy `protobuf:"varint,1,opt,name=privacy,proto3,enum=protobuf.LobbyPrivacy" json:"privacy,omitempty"`
+}
+
+func (x *SetLobbyPrivacyRequest) Reset() {
+	*x = SetLobbyPrivacyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyPrivacyRequest) ProtoMessage() {}
+
+func (x *SetLobbyPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*SetLobbyPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetLobbyPrivacyRequest) GetPrivacy() LobbyPrivacy {
+	if x != nil {
+		return x.Privacy
+	}
+	return LobbyPrivacy_LOBBY_PRIVACY_HOST_APPROVAL
+}
+
+// ListJoinRequestsRequest asks the lobby host for the pending join requests of
+// its lobby, answered with LobbyJoinRequestsResponse.
+type ListJoinRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{15}
+}
+
+// SearchLobbiesRequest asks for open lobbies of the mode with room for another
+// player, answered with LobbySearchResponse holding at most limit lobbies.
+type SearchLobbiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchLobbiesRequest) Reset() {
+	*x = SearchLobbiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLobbiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLobbiesRequest) ProtoMessage() {}
+
+func (x *SearchLobbiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLobbiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchLobbiesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchLobbiesRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *SearchLobbiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// LobbyChatRequest sends a text message, or a quick emote when emote is set, to
+// the members of the lobby.
+type LobbyChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text  string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Emote uint32 `protobuf:"varint,2,opt,name=emote,proto3" json:"emote,omitempty"`
+}
+
+func (x *LobbyChatRequest) Reset() {
+	*x = LobbyChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyChatRequest) ProtoMessage() {}
+
+func (x *LobbyChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyChatRequest.ProtoReflect.Descriptor instead.
+func (*LobbyChatRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LobbyChatRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LobbyChatRequest) GetEmote() uint32 {
+	if x != nil {
+		return x.Emote
+	}
+	return 0
+}
+
+// GameRequest wraps every request message for clients which prefer a single
+// message type. The field numbers match the request codes of the packet format.
+type GameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*GameRequest_SyncPos
+	//	*GameRequest_Logout
+	//	*GameRequest_CreateLobby
+	//	*GameRequest_InviteLobby
+	//	*GameRequest_LeaveLobby
+	//	*GameRequest_AcceptLobbyInvites
+	//	*GameRequest_Reliable
+	//	*GameRequest_RequestJoinLobby
+	//	*GameRequest_RespondJoinLobby
+	//	*GameRequest_DismissLobby
+	//	*GameRequest_SetLobbyMode
+	//	*GameRequest_SetLobbyHost
+	//	*GameRequest_SetLobbyReady
+	//	*GameRequest_KickLobbyPlayer
+	//	*GameRequest_ListLobbyInvitations
+	//	*GameRequest_SetLobbyPrivacy
+	//	*GameRequest_ListJoinRequests
+	//	*GameRequest_SearchLobbies
+	//	*GameRequest_LobbyChat
+	Request isGameRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *GameRequest) Reset() {
+	*x = GameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_request_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRequest) ProtoMessage() {}
+
+func (x *GameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_request_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRequest.ProtoReflect.Descriptor instead.
+func (*GameRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_request_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *GameRequest) GetRequest() isGameRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSyncPos() *SyncPosRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SyncPos); ok {
+		return x.SyncPos
+	}
+	return nil
+}
+
+func (x *GameRequest) GetLogout() *LogoutRequest {
+	if x, ok := x.GetRequest().(*GameRequest_Logout); ok {
+		return x.Logout
+	}
+	return nil
+}
+
+func (x *GameRequest) GetCreateLobby() *CreateLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_CreateLobby); ok {
+		return x.CreateLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetInviteLobby() *InviteLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_InviteLobby); ok {
+		return x.InviteLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetLeaveLobby() *LeaveLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_LeaveLobby); ok {
+		return x.LeaveLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetAcceptLobbyInvites() *AcceptLobbyInvitesRequest {
+	if x, ok := x.GetRequest().(*GameRequest_AcceptLobbyInvites); ok {
+		return x.AcceptLobbyInvites
+	}
+	return nil
+}
+
+func (x *GameRequest) GetReliable() *ReliableFrame {
+	if x, ok := x.GetRequest().(*GameRequest_Reliable); ok {
+		return x.Reliable
+	}
+	return nil
+}
+
+func (x *GameRequest) GetRequestJoinLobby() *RequestJoinLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_RequestJoinLobby); ok {
+		return x.RequestJoinLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetRespondJoinLobby() *RespondJoinLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_RespondJoinLobby); ok {
+		return x.RespondJoinLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetDismissLobby() *DismissLobbyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_DismissLobby); ok {
+		return x.DismissLobby
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSetLobbyMode() *SetLobbyModeRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SetLobbyMode); ok {
+		return x.SetLobbyMode
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSetLobbyHost() *SetLobbyHostRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SetLobbyHost); ok {
+		return x.SetLobbyHost
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSetLobbyReady() *SetLobbyReadyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SetLobbyReady); ok {
+		return x.SetLobbyReady
+	}
+	return nil
+}
+
+func (x *GameRequest) GetKickLobbyPlayer() *KickLobbyPlayerRequest {
+	if x, ok := x.GetRequest().(*GameRequest_KickLobbyPlayer); ok {
+		return x.KickLobbyPlayer
+	}
+	return nil
+}
+
+func (x *GameRequest) GetListLobbyInvitations() *ListLobbyInvitationsRequest {
+	if x, ok := x.GetRequest().(*GameRequest_ListLobbyInvitations); ok {
+		return x.ListLobbyInvitations
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSetLobbyPrivacy() *SetLobbyPrivacyRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SetLobbyPrivacy); ok {
+		return x.SetLobbyPrivacy
+	}
+	return nil
+}
+
+func (x *GameRequest) GetListJoinRequests() *ListJoinRequestsRequest {
+	if x, ok := x.GetRequest().(*GameRequest_ListJoinRequests); ok {
+		return x.ListJoinRequests
+	}
+	return nil
+}
+
+func (x *GameRequest) GetSearchLobbies() *SearchLobbiesRequest {
+	if x, ok := x.GetRequest().(*GameRequest_SearchLobbies); ok {
+		return x.SearchLobbies
+	}
+	return nil
+}
+
+func (x *GameRequest) GetLobbyChat() *LobbyChatRequest {
+	if x, ok := x.GetRequest().(*GameRequest_LobbyChat); ok {
+		return x.LobbyChat
+	}
+	return nil
+}
+
+type isGameRequest_Request interface {
+	isGameRequest_Request()
+}
+
+type GameRequest_SyncPos struct {
+	SyncPos *SyncPosRequest `protobuf:"bytes,1,opt,name=sync_pos,json=syncPos,proto3,oneof"`
+}
+
+type GameRequest_Logout struct {
+	Logout *LogoutRequest `protobuf:"bytes,2,opt,name=logout,proto3,oneof"`
+}
+
+type GameRequest_CreateLobby struct {
+	CreateLobby *CreateLobbyRequest `protobuf:"bytes,3,opt,name=create_lobby,json=createLobby,proto3,oneof"`
+}
+
+type GameRequest_InviteLobby struct {
+	InviteLobby *InviteLobbyRequest `protobuf:"bytes,4,opt,name=invite_lobby,json=inviteLobby,proto3,oneof"`
+}
+
+type GameRequest_LeaveLobby struct {
+	LeaveLobby *LeaveLobbyRequest `protobuf:"bytes,5,opt,name=leave_lobby,json=leaveLobby,proto3,oneof"`
+}
+
+type GameRequest_AcceptLobbyInvites struct {
+	AcceptLobbyInvites *AcceptLobbyInvitesRequest `protobuf:"bytes,6,opt,name=accept_lobby_invites,json=acceptLobbyInvites,proto3,oneof"`
+}
+
+type GameRequest_Reliable struct {
+	Reliable *ReliableFrame `protobuf:"bytes,7,opt,name=reliable,proto3,oneof"`
+}
+
+type GameRequest_RequestJoinLobby struct {
+	RequestJoinLobby *RequestJoinLobbyRequest `protobuf:"bytes,8,opt,name=request_join_lobby,json=requestJoinLobby,proto3,oneof"`
+}
+
+type GameRequest_RespondJoinLobby struct {
+	RespondJoinLobby *RespondJoinLobbyRequest `protobuf:"bytes,9,opt,name=respond_join_lobby,json=respondJoinLobby,proto3,oneof"`
+}
+
+type GameRequest_DismissLobby struct {
+	DismissLobby *DismissLobbyRequest `protobuf:"bytes,10,opt,name=dismiss_lobby,json=dismissLobby,proto3,oneof"`
+}
+
+type GameRequest_SetLobbyMode struct {
+	SetLobbyMode *SetLobbyModeRequest `protobuf:"bytes,11,opt,name=set_lobby_mode,json=setLobbyMode,proto3,oneof"`
+}
+
+type GameRequest_SetLobbyHost struct {
+	SetLobbyHost *SetLobbyHostRequest `protobuf:"bytes,12,opt,name=set_lobby_host,json=setLobbyHost,proto3,oneof"`
+}
+
+type GameRequest_SetLobbyReady struct {
+	SetLobbyReady *SetLobbyReadyRequest `protobuf:"bytes,13,opt,name=set_lobby_ready,json=setLobbyReady,proto3,oneof"`
+}
+
+type GameRequest_KickLobbyPlayer struct {
+	KickLobbyPlayer *KickLobbyPlayerRequest `protobuf:"bytes,14,opt,name=kick_lobby_player,json=kickLobbyPlayer,proto3,oneof"`
+}
+
+type GameRequest_ListLobbyInvitations struct {
+	ListLobbyInvitations *ListLobbyInvitationsRequest `protobuf:"bytes,15,opt,name=list_lobby_invitations,json=listLobbyInvitations,proto3,oneof"`
+}
+
+type GameRequest_SetLobbyPrivacy struct {
+	SetLobbyPrivacy *SetLobbyPrivacyRequest `protobuf:"bytes,16,opt,name=set_lobby_privacy,json=setLobbyPrivacy,proto3,oneof"`
+}
+
+type GameRequest_ListJoinRequests struct {
+	ListJoinRequests *ListJoinRequestsRequest `protobuf:"bytes,17,opt,name=list_join_requests,json=listJoinRequests,proto3,oneof"`
+}
+
+type GameRequest_SearchLobbies struct {
+	SearchLobbies *SearchLobbiesRequest `protobuf:"bytes,18,opt,name=search_lobbies,json=searchLobbies,proto3,oneof"`
+}
+
+type GameRequest_LobbyChat struct {
+	LobbyChat *LobbyChatRequest `protobuf:"bytes,19,opt,name=lobby_chat,json=lobbyChat,proto3,oneof"`
+}
+
+func (*GameRequest_SyncPos) isGameRequest_Request() {}
+
+func (*GameRequest_Logout) isGameRequest_Request() {}
+
+func (*GameRequest_CreateLobby) isGameRequest_Request() {}
+
+func (*GameRequest_InviteLobby) isGameRequest_Request() {}
+
+func (*GameRequest_LeaveLobby) isGameRequest_Request() {}
+
+func (*GameRequest_AcceptLobbyInvites) isGameRequest_Request() {}
+
+func (*GameRequest_Reliable) isGameRequest_Request() {}
+
+func (*GameRequest_RequestJoinLobby) isGameRequest_Request() {}
+
+func (*GameRequest_RespondJoinLobby) isGameRequest_Request() {}
+
+func (*GameRequest_DismissLobby) isGameRequest_Request() {}
+
+func (*GameRequest_SetLobbyMode) isGameRequest_Request() {}
+
+func (*GameRequest_SetLobbyHost) isGameRequest_Request() {}
+
+func (*GameRequest_SetLobbyReady) isGameRequest_Request() {}
+
+func (*GameRequest_KickLobbyPlayer) isGameRequest_Request() {}
+
+func (*GameRequest_ListLobbyInvitations) isGameRequest_Request() {}
+
+func (*GameRequest_SetLobbyPrivacy) isGameRequest_Request() {}
+
+func (*GameRequest_ListJoinRequests) isGameRequest_Request() {}
+
+func (*GameRequest_SearchLobbies) isGameRequest_Request() {}
+
+func (*GameRequest_LobbyChat) isGameRequest_Request() {}
+
 var File_protobuf_game_request_proto protoreflect.FileDescriptor
 
 var file_protobuf_game_request_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xe1, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x37, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x53, 0x69, 0x64, 0x78, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x56, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x69, 0x64,
+	0x78, 0x22, 0x58, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x69, 0x64, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x44,
+	0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x32, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x64,
+	0x78, 0x22, 0x2c, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22,
+	0x2c, 0x0a, 0x16, 0x4b, 0x69, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x22, 0x1d, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x22, 0xf4, 0x0a, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x12, 0x41, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x51, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12,
+	0x45, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x48, 0x0a,
+	0x0f, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x4e, 0x0a, 0x11, 0x6b, 0x69, 0x63, 0x6b, 0x5f,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4b, 0x69,
+	0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6b, 0x69, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x51, 0x0a, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x62, 0x62, 0x69,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x74,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
-var file_protobuf_game_request_proto_goTypes = []interface{}{}
+var (
+	file_protobuf_game_request_proto_rawDescOnce sync.Once
+	file_protobuf_game_request_proto_rawDescData = file_protobuf_game_request_proto_rawDesc
+)
+
+func file_protobuf_game_request_proto_rawDescGZIP() []byte {
+	file_protobuf_game_request_proto_rawDescOnce.Do(func() {
+		file_protobuf_game_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_game_request_proto_rawDescData)
+	})
+	return file_protobuf_game_request_proto_rawDescData
+}
+
+var file_protobuf_game_request_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_protobuf_game_request_proto_goTypes = []interface{}{
+	(*SyncPosRequest)(nil),              // 0: protobuf.SyncPosRequest
+	(*LogoutRequest)(nil),               // 1: protobuf.LogoutRequest
+	(*CreateLobbyRequest)(nil),          // 2: protobuf.CreateLobbyRequest
+	(*InviteLobbyRequest)(nil),          // 3: protobuf.InviteLobbyRequest
+	(*LeaveLobbyRequest)(nil),           // 4: protobuf.LeaveLobbyRequest
+	(*AcceptLobbyInvitesRequest)(nil),   // 5: protobuf.AcceptLobbyInvitesRequest
+	(*RequestJoinLobbyRequest)(nil),     // 6: protobuf.RequestJoinLobbyRequest
+	(*RespondJoinLobbyRequest)(nil),     // 7: protobuf.RespondJoinLobbyRequest
+	(*DismissLobbyRequest)(nil),         // 8: protobuf.DismissLobbyRequest
+	(*SetLobbyModeRequest)(nil),         // 9: protobuf.SetLobbyModeRequest
+	(*SetLobbyHostRequest)(nil),         // 10: protobuf.SetLobbyHostRequest
+	(*SetLobbyReadyRequest)(nil),        // 11: protobuf.SetLobbyReadyRequest
+	(*KickLobbyPlayerRequest)(nil),      // 12: protobuf.KickLobbyPlayerRequest
+	(*ListLobbyInvitationsRequest)(nil), // 13: protobuf.ListLobbyInvitationsRequest
+	(*SetLobbyPrivacyRequest)(nil),      // 14: protobuf.SetLobbyPrivacyRequest
+	(*ListJoinRequestsRequest)(nil),     // 15: protobuf.ListJoinRequestsRequest
+	(*SearchLobbiesRequest)(nil),        // 16: protobuf.SearchLobbiesRequest
+	(*LobbyChatRequest)(nil),            // 17: protobuf.LobbyChatRequest
+	(*GameRequest)(nil),                 // 18: protobuf.GameRequest
+	(*Vector3)(nil),                     // 19: protobuf.Vector3
+	(*Quaternion)(nil),                  // 20: protobuf.Quaternion
+	(LobbyPrivacy)(0),                   // 21: protobuf.LobbyPrivacy
+	(*ReliableFrame)(nil),               // 22: protobuf.ReliableFrame
+}
 var file_protobuf_game_request_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	19, // 0: protobuf.SyncPosRequest.position:type_name -> protobuf.Vector3
+	20, // 1: protobuf.SyncPosRequest.rotation:type_name -> protobuf.Quaternion
+	19, // 2: protobuf.SyncPosRequest.velocity:type_name -> protobuf.Vector3
+	21, // 3: protobuf.SetLobbyPrivacyRequest.privacy:type_name -> protobuf.LobbyPrivacy
+	0,  // 4: protobuf.GameRequest.sync_pos:type_name -> protobuf.SyncPosRequest
+	1,  // 5: protobuf.GameRequest.logout:type_name -> protobuf.LogoutRequest
+	2,  // 6: protobuf.GameRequest.create_lobby:type_name -> protobuf.CreateLobbyRequest
+	3,  // 7: protobuf.GameRequest.invite_lobby:type_name -> protobuf.InviteLobbyRequest
+	4,  // 8: protobuf.GameRequest.leave_lobby:type_name -> protobuf.LeaveLobbyRequest
+	5,  // 9: protobuf.GameRequest.accept_lobby_invites:type_name -> protobuf.AcceptLobbyInvitesRequest
+	22, // 10: protobuf.GameRequest.reliable:type_name -> protobuf.ReliableFrame
+	6,  // 11: protobuf.GameRequest.request_join_lobby:type_name -> protobuf.RequestJoinLobbyRequest
+	7,  // 12: protobuf.GameRequest.respond_join_lobby:type_name -> protobuf.RespondJoinLobbyRequest
+	8,  // 13: protobuf.GameRequest.dismiss_lobby:type_name -> protobuf.DismissLobbyRequest
+	9,  // 14: protobuf.GameRequest.set_lobby_mode:type_name -> protobuf.SetLobbyModeRequest
+	10, // 15: protobuf.GameRequest.set_lobby_host:type_name -> protobuf.SetLobbyHostRequest
+	11, // 16: protobuf.GameRequest.set_lobby_ready:type_name -> protobuf.SetLobbyReadyRequest
+	12, // 17: protobuf.GameRequest.kick_lobby_player:type_name -> protobuf.KickLobbyPlayerRequest
+	13, // 18: protobuf.GameRequest.list_lobby_invitations:type_name -> protobuf.ListLobbyInvitationsRequest
+	14, // 19: protobuf.GameRequest.set_lobby_privacy:type_name -> protobuf.SetLobbyPrivacyRequest
+	15, // 20: protobuf.GameRequest.list_join_requests:type_name -> protobuf.ListJoinRequestsRequest
+	16, // 21: protobuf.GameRequest.search_lobbies:type_name -> protobuf.SearchLobbiesRequest
+	17, // 22: protobuf.GameRequest.lobby_chat:type_name -> protobuf.LobbyChatRequest
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_game_request_proto_init() }
@@ -42,18 +1458,271 @@ func file_protobuf_game_request_proto_init() {
 	if File_protobuf_game_request_proto != nil {
 		return
 	}
+	file_protobuf_game_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_game_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncPosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptLobbyInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestJoinLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondJoinLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyReadyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickLobbyPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbyInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyPrivacyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJoinRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLobbiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_request_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_protobuf_game_request_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*GameRequest_SyncPos)(nil),
+		(*GameRequest_Logout)(nil),
+		(*GameRequest_CreateLobby)(nil),
+		(*GameRequest_InviteLobby)(nil),
+		(*GameRequest_LeaveLobby)(nil),
+		(*GameRequest_AcceptLobbyInvites)(nil),
+		(*GameRequest_Reliable)(nil),
+		(*GameRequest_RequestJoinLobby)(nil),
+		(*GameRequest_RespondJoinLobby)(nil),
+		(*GameRequest_DismissLobby)(nil),
+		(*GameRequest_SetLobbyMode)(nil),
+		(*GameRequest_SetLobbyHost)(nil),
+		(*GameRequest_SetLobbyReady)(nil),
+		(*GameRequest_KickLobbyPlayer)(nil),
+		(*GameRequest_ListLobbyInvitations)(nil),
+		(*GameRequest_SetLobbyPrivacy)(nil),
+		(*GameRequest_ListJoinRequests)(nil),
+		(*GameRequest_SearchLobbies)(nil),
+		(*GameRequest_LobbyChat)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_game_request_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_protobuf_game_request_proto_goTypes,
 		DependencyIndexes: file_protobuf_game_request_proto_depIdxs,
+		MessageInfos:      file_protobuf_game_request_proto_msgTypes,
 	}.Build()
 	File_protobuf_game_request_proto = out.File
 	file_protobuf_game_request_proto_rawDesc = nil
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -19,22 +20,2298 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DisconnectReason int32
+
+const (
+	DisconnectReason_DISCONNECT_REASON_UNKNOWN DisconnectReason = 0
+	DisconnectReason_DISCONNECT_REASON_KICKED  DisconnectReason = 1
+	DisconnectReason_DISCONNECT_REASON_EXPIRED DisconnectReason = 2
+)
+
+// Enum value maps for DisconnectReason.
+var (
+	DisconnectReason_name = map[int32]string{
+		0: "DISCONNECT_REASON_UNKNOWN",
+		1: "DISCONNECT_REASON_KICKED",
+		2: "DISCONNECT_REASON_EXPIRED",
+	}
+	DisconnectReason_value = map[string]int32{
+		"DISCONNECT_REASON_UNKNOWN": 0,
+		"DISCONNECT_REASON_KICKED":  1,
+		"DISCONNECT_REASON_EXPIRED": 2,
+	}
+)
+
+func (x DisconnectReason) Enum() *DisconnectReason {
+	p := new(DisconnectReason)
+	*p = x
+	return p
+}
+
+func (x DisconnectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisconnectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_game_response_proto_enumTypes[0].Descriptor()
+}
+
+func (DisconnectReason) Type() protoreflect.EnumType {
+	return &file_protobuf_game_response_proto_enumTypes[0]
+}
+
+func (x DisconnectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisconnectReason.Descriptor instead.
+func (DisconnectReason) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{0}
+}
+
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNKNOWN         ErrorCode = 0
+	ErrorCode_ERROR_CODE_UNKNOWN_REQUEST ErrorCode = 1
+	ErrorCode_ERROR_CODE_INVALID_REQUEST ErrorCode = 2
+	ErrorCode_ERROR_CODE_INVALID_STATE   ErrorCode = 3
+	ErrorCode_ERROR_CODE_INTERNAL        ErrorCode = 4
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNKNOWN",
+		1: "ERROR_CODE_UNKNOWN_REQUEST",
+		2: "ERROR_CODE_INVALID_REQUEST",
+		3: "ERROR_CODE_INVALID_STATE",
+		4: "ERROR_CODE_INTERNAL",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNKNOWN":         0,
+		"ERROR_CODE_UNKNOWN_REQUEST": 1,
+		"ERROR_CODE_INVALID_REQUEST": 2,
+		"ERROR_CODE_INVALID_STATE":   3,
+		"ERROR_CODE_INTERNAL":        4,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_game_response_proto_enumTypes[1].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_protobuf_game_response_proto_enumTypes[1]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{1}
+}
+
+type LobbyEvent int32
+
+const (
+	LobbyEvent_LOBBY_EVENT_UNKNOWN       LobbyEvent = 0
+	LobbyEvent_LOBBY_EVENT_JOINED        LobbyEvent = 1
+	LobbyEvent_LOBBY_EVENT_LEFT          LobbyEvent = 2
+	LobbyEvent_LOBBY_EVENT_KICKED        LobbyEvent = 3
+	LobbyEvent_LOBBY_EVENT_DISMISSED     LobbyEvent = 4
+	LobbyEvent_LOBBY_EVENT_MODE          LobbyEvent = 5
+	LobbyEvent_LOBBY_EVENT_HOST          LobbyEvent = 6
+	LobbyEvent_LOBBY_EVENT_READY         LobbyEvent = 7
+	LobbyEvent_LOBBY_EVENT_DECLINED      LobbyEvent = 8
+	LobbyEvent_LOBBY_EVENT_PRIVACY       LobbyEvent = 9
+	LobbyEvent_LOBBY_EVENT_HOST_MIGRATED LobbyEvent = 10
+)
+
+// Enum value maps for LobbyEvent.
+var (
+	LobbyEvent_name = map[int32]string{
+		0:  "LOBBY_EVENT_UNKNOWN",
+		1:  "LOBBY_EVENT_JOINED",
+		2:  "LOBBY_EVENT_LEFT",
+		3:  "LOBBY_EVENT_KICKED",
+		4:  "LOBBY_EVENT_DISMISSED",
+		5:  "LOBBY_EVENT_MODE",
+		6:  "LOBBY_EVENT_HOST",
+		7:  "LOBBY_EVENT_READY",
+		8:  "LOBBY_EVENT_DECLINED",
+		9:  "LOBBY_EVENT_PRIVACY",
+		10: "LOBBY_EVENT_HOST_MIGRATED",
+	}
+	LobbyEvent_value = map[string]int32{
+		"LOBBY_EVENT_UNKNOWN":       0,
+		"LOBBY_EVENT_JOINED":        1,
+		"LOBBY_EVENT_LEFT":          2,
+		"LOBBY_EVENT_KICKED":        3,
+		"LOBBY_EVENT_DISMISSED":     4,
+		"LOBBY_EVENT_MODE":          5,
+		"LOBBY_EVENT_HOST":          6,
+		"LOBBY_EVENT_READY":         7,
+		"LOBBY_EVENT_DECLINED":      8,
+		"LOBBY_EVENT_PRIVACY":       9,
+		"LOBBY_EVENT_HOST_MIGRATED": 10,
+	}
+)
+
+func (x LobbyEvent) Enum() *LobbyEvent {
+	p := new(LobbyEvent)
+	*p = x
+	return p
+}
+
+func (x LobbyEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_game_response_proto_enumTypes[2].Descriptor()
+}
+
+func (LobbyEvent) Type() protoreflect.EnumType {
+	return &file_protobuf_game_response_proto_enumTypes[2]
+}
+
+func (x LobbyEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyEvent.Descriptor instead.
+func (LobbyEvent) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{2}
+}
+
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_UNKNOWN   InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_ACCEPTED  InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_DECLINED  InvitationStatus = 2
+	InvitationStatus_INVITATION_STATUS_EXPIRED   InvitationStatus = 3
+	InvitationStatus_INVITATION_STATUS_CANCELLED InvitationStatus = 4
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNKNOWN",
+		1: "INVITATION_STATUS_ACCEPTED",
+		2: "INVITATION_STATUS_DECLINED",
+		3: "INVITATION_STATUS_EXPIRED",
+		4: "INVITATION_STATUS_CANCELLED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNKNOWN":   0,
+		"INVITATION_STATUS_ACCEPTED":  1,
+		"INVITATION_STATUS_DECLINED":  2,
+		"INVITATION_STATUS_EXPIRED":   3,
+		"INVITATION_STATUS_CANCELLED": 4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_game_response_proto_enumTypes[3].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_protobuf_game_response_proto_enumTypes[3]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{3}
+}
+
+// SyncPosResponse carries the latest movement state of the player with the
+// provided session handle. timestamp is the server clock in milliseconds.
+type SyncPosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx      uint32      `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+	Position  *Vector3    `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation  *Quaternion `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Velocity  *Vector3    `protobuf:"bytes,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Timestamp uint32      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SyncPosResponse) Reset() {
+	*x = SyncPosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncPosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPosResponse) ProtoMessage() {}
+
+func (x *SyncPosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPosResponse.ProtoReflect.Descriptor instead.
+func (*SyncPosResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyncPosResponse) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+func (x *SyncPosResponse) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *SyncPosResponse) GetRotation() *Quaternion {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *SyncPosResponse) GetVelocity() *Vector3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *SyncPosResponse) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type DisconnectedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason DisconnectReason `protobuf:"varint,1,opt,name=reason,proto3,enum=protobuf.DisconnectReason" json:"reason,omitempty"`
+}
+
+func (x *DisconnectedResponse) Reset() {
+	*x = DisconnectedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectedResponse) ProtoMessage() {}
+
+func (x *DisconnectedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectedResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectedResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DisconnectedResponse) GetReason() DisconnectReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DisconnectReason_DISCONNECT_REASON_UNKNOWN
+}
+
+// ConnectedResponse acknowledges the first packet of a session. server_time is
+// the server clock in unix milliseconds.
+type ConnectedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx       uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+	ServerTime int64  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+}
+
+func (x *ConnectedResponse) Reset() {
+	*x = ConnectedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectedResponse) ProtoMessage() {}
+
+func (x *ConnectedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectedResponse.ProtoReflect.Descriptor instead.
+func (*ConnectedResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConnectedResponse) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+func (x *ConnectedResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+// ReconnectingResponse notifies the client that its session has been resumed
+// by another login, keeping its game state.
+type ReconnectingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+}
+
+func (x *ReconnectingResponse) Reset() {
+	*x = ReconnectingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconnectingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectingResponse) ProtoMessage() {}
+
+func (x *ReconnectingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectingResponse.ProtoReflect.Descriptor instead.
+func (*ReconnectingResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReconnectingResponse) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+type CreateLobbyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyIdx uint32 `protobuf:"varint,1,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+	Mode     uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	HostSidx uint32 `protobuf:"varint,3,opt,name=host_sidx,json=hostSidx,proto3" json:"host_sidx,omitempty"`
+}
+
+func (x *CreateLobbyResponse) Reset() {
+	*x = CreateLobbyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobbyResponse) ProtoMessage() {}
+
+func (x *CreateLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobbyResponse.ProtoReflect.Descriptor instead.
+func (*CreateLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateLobbyResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+func (x *CreateLobbyResponse) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *CreateLobbyResponse) GetHostSidx() uint32 {
+	if x != nil {
+		return x.HostSidx
+	}
+	return 0
+}
+
+type JoinLobbyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyIdx  uint32   `protobuf:"varint,1,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+	Mode      uint32   `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	HostSidx  uint32   `protobuf:"varint,3,opt,name=host_sidx,json=hostSidx,proto3" json:"host_sidx,omitempty"`
+	GuestSidx []uint32 `protobuf:"varint,4,rep,packed,name=guest_sidx,json=guestSidx,proto3" json:"guest_sidx,omitempty"`
+}
+
+func (x *JoinLobbyResponse) Reset() {
+	*x = JoinLobbyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinLobbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinLobbyResponse) ProtoMessage() {}
+
+func (x *JoinLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinLobbyResponse.ProtoReflect.Descriptor instead.
+func (*JoinLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JoinLobbyResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+func (x *JoinLobbyResponse) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *JoinLobbyResponse) GetHostSidx() uint32 {
+	if x != nil {
+		return x.HostSidx
+	}
+	return 0
+}
+
+func (x *JoinLobbyResponse) GetGuestSidx() []uint32 {
+	if x != nil {
+		return x.GuestSidx
+	}
+	return nil
+}
+
+// RekeyResponse notifies the client that the session key has been rotated. It is
+// sealed with the previous key, the new key is derived from the session shared key
+// with HKDF-SHA256 and info "gameserver session rekey" followed by the big-endian epoch.
+type RekeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *RekeyResponse) Reset() {
+	*x = RekeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyResponse) ProtoMessage() {}
+
+func (x *RekeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyResponse.ProtoReflect.Descriptor instead.
+func (*RekeyResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RekeyResponse) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// ErrorResponse notifies the client that one of its requests has been rejected.
+type ErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestCode uint32    `protobuf:"varint,1,opt,name=request_code,json=requestCode,proto3" json:"request_code,omitempty"`
+	Code        ErrorCode `protobuf:"varint,2,opt,name=code,proto3,enum=protobuf.ErrorCode" json:"code,omitempty"`
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ErrorResponse) GetRequestCode() uint32 {
+	if x != nil {
+		return x.RequestCode
+	}
+	return 0
+}
+
+func (x *ErrorResponse) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNKNOWN
+}
+
+// PlayerDelta carries the state of a match player which changed since the
+// snapshot baseline. Unset fields are unchanged from the baseline, and removed
+// is set for a player whose state is no longer known.
+type PlayerDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx      uint32      `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+	Position  *Vector3    `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation  *Quaternion `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Velocity  *Vector3    `protobuf:"bytes,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Timestamp uint32      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Removed   bool        `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *PlayerDelta) Reset() {
+	*x = PlayerDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDelta) ProtoMessage() {}
+
+func (x *PlayerDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDelta.ProtoReflect.Descriptor instead.
+func (*PlayerDelta) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerDelta) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+func (x *PlayerDelta) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerDelta) GetRotation() *Quaternion {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *PlayerDelta) GetVelocity() *Vector3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *PlayerDelta) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PlayerDelta) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// SnapshotResponse carries the states of the other match players. When
+// baseline is 0 the snapshot is full and lists every known player, otherwise
+// it only lists the players which changed since the snapshot with sequence
+// baseline, which the client acknowledged.
+type SnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence   uint32         `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Baseline   uint32         `protobuf:"varint,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	ServerTime int64          `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	Players    []*PlayerDelta `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *SnapshotResponse) Reset() {
+	*x = SnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotResponse) ProtoMessage() {}
+
+func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SnapshotResponse) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SnapshotResponse) GetBaseline() uint32 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+func (x *SnapshotResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *SnapshotResponse) GetPlayers() []*PlayerDelta {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type LobbyMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sidx          uint32 `protobuf:"varint,1,opt,name=sidx,proto3" json:"sidx,omitempty"`
+	Ready         bool   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	InvitedBySidx uint32 `protobuf:"varint,3,opt,name=invited_by_sidx,json=invitedBySidx,proto3" json:"invited_by_sidx,omitempty"`
+}
+
+func (x *LobbyMember) Reset() {
+	*x = LobbyMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyMember) ProtoMessage() {}
+
+func (x *LobbyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyMember.ProtoReflect.Descriptor instead.
+func (*LobbyMember) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LobbyMember) GetSidx() uint32 {
+	if x != nil {
+		return x.Sidx
+	}
+	return 0
+}
+
+func (x *LobbyMember) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *LobbyMember) GetInvitedBySidx() uint32 {
+	if x != nil {
+		return x.InvitedBySidx
+	}
+	return 0
+}
+
+// LobbyUpdateResponse notifies a lobby member that the lobby changed because of
+// event, caused by or targeting the player subject_sidx, and carries the lobby
+// after the change. A player who is no longer a member after the event, e.g.
+// because the lobby has been dismissed or the player has been kicked, only
+// receives the lobby_idx. LOBBY_EVENT_DECLINED is sent to a player whose join
+// request has been declined by subject_sidx. LOBBY_EVENT_HOST_MIGRATED is sent
+// when the host has left or timed out and the guest subject_sidx has been
+// promoted to host.
+type LobbyUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event       LobbyEvent     `protobuf:"varint,1,opt,name=event,proto3,enum=protobuf.LobbyEvent" json:"event,omitempty"`
+	SubjectSidx uint32         `protobuf:"varint,2,opt,name=subject_sidx,json=subjectSidx,proto3" json:"subject_sidx,omitempty"`
+	LobbyIdx    uint32         `protobuf:"varint,3,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+	Mode        uint32         `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	HostSidx    uint32         `protobuf:"varint,5,opt,name=host_sidx,json=hostSidx,proto3" json:"host_sidx,omitempty"`
+	Guests      []*LobbyMember `protobuf:"bytes,6,rep,name=guests,proto3" json:"guests,omitempty"`
+	Privacy     LobbyPrivacy   `protobuf:"varint,7,opt,name=privacy,proto3,enum=protobuf.LobbyPrivacy" json:"privacy,omitempty"`
+}
+
+func (x *LobbyUpdateResponse) Reset() {
+	*x = LobbyUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyUpdateResponse) ProtoMessage() {}
+
+func (x *LobbyUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyUpdateResponse.ProtoReflect.Descriptor instead.
+func (*LobbyUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LobbyUpdateResponse) GetEvent() LobbyEvent {
+	if x != nil {
+		return x.Event
+	}
+	return LobbyEvent_LOBBY_EVENT_UNKNOWN
+}
+
+func (x *LobbyUpdateResponse) GetSubjectSidx() uint32 {
+	if x != nil {
+		return x.SubjectSidx
+	}
+	return 0
+}
+
+func (x *LobbyUpdateResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+func (x *LobbyUpdateResponse) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *LobbyUpdateResponse) GetHostSidx() uint32 {
+	if x != nil {
+		return x.HostSidx
+	}
+	return 0
+}
+
+func (x *LobbyUpdateResponse) GetGuests() []*LobbyMember {
+	if x != nil {
+		return x.Guests
+	}
+	return nil
+}
+
+func (x *LobbyUpdateResponse) GetPrivacy() LobbyPrivacy {
+	if x != nil {
+		return x.Privacy
+	}
+	return LobbyPrivacy_LOBBY_PRIVACY_HOST_APPROVAL
+}
+
+// LobbyInvitationResponse notifies a player of an invitation to the lobby of
+// invitor_sidx, answered with AcceptLobbyInvitesRequest before expires, the
+// server clock in unix milliseconds.
+type LobbyInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitorSidx uint32 `protobuf:"varint,1,opt,name=invitor_sidx,json=invitorSidx,proto3" json:"invitor_sidx,omitempty"`
+	LobbyIdx    uint32 `protobuf:"varint,2,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+	Mode        uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Expires     int64  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *LobbyInvitationResponse) Reset() {
+	*x = LobbyInvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitationResponse) ProtoMessage() {}
+
+func (x *LobbyInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitationResponse.ProtoReflect.Descriptor instead.
+func (*LobbyInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LobbyInvitationResponse) GetInvitorSidx() uint32 {
+	if x != nil {
+		return x.InvitorSidx
+	}
+	return 0
+}
+
+func (x *LobbyInvitationResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+func (x *LobbyInvitationResponse) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *LobbyInvitationResponse) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+// LobbyInvitationUpdateResponse notifies both the invitor and the invitee that
+// an invitation is no longer pending. An invitation is cancelled when the
+// invitor leaves its lobby, the lobby enters the matchmaking queue, or the
+// invitee joins another lobby or goes offline.
+type LobbyInvitationUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      InvitationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=protobuf.InvitationStatus" json:"status,omitempty"`
+	InvitorSidx uint32           `protobuf:"varint,2,opt,name=invitor_sidx,json=invitorSidx,proto3" json:"invitor_sidx,omitempty"`
+	InviteeSidx uint32           `protobuf:"varint,3,opt,name=invitee_sidx,json=inviteeSidx,proto3" json:"invitee_sidx,omitempty"`
+	LobbyIdx    uint32           `protobuf:"varint,4,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+}
+
+func (x *LobbyInvitationUpdateResponse) Reset() {
+	*x = LobbyInvitationUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitationUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitationUpdateResponse) ProtoMessage() {}
+
+func (x *LobbyInvitationUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitationUpdateResponse.ProtoReflect.Descriptor instead.
+func (*LobbyInvitationUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LobbyInvitationUpdateResponse) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNKNOWN
+}
+
+func (x *LobbyInvitationUpdateResponse) GetInvitorSidx() uint32 {
+	if x != nil {
+		return x.InvitorSidx
+	}
+	return 0
+}
+
+func (x *LobbyInvitationUpdateResponse) GetInviteeSidx() uint32 {
+	if x != nil {
+		return x.InviteeSidx
+	}
+	return 0
+}
+
+func (x *LobbyInvitationUpdateResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+// LobbyInvitationsResponse lists the pending invitations of the player, oldest
+// first.
+type LobbyInvitationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*LobbyInvitationResponse `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *LobbyInvitationsResponse) Reset() {
+	*x = LobbyInvitationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitationsResponse) ProtoMessage() {}
+
+func (x *LobbyInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*LobbyInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LobbyInvitationsResponse) GetInvitations() []*LobbyInvitationResponse {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// LobbyJoinRequestResponse notifies the lobby host that requester_sidx asks to
+// join the lobby, answered with RespondJoinLobbyRequest.
+type LobbyJoinRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequesterSidx uint32 `protobuf:"varint,1,opt,name=requester_sidx,json=requesterSidx,proto3" json:"requester_sidx,omitempty"`
+	LobbyIdx      uint32 `protobuf:"varint,2,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+}
+
+func (x *LobbyJoinRequestResponse) Reset() {
+	*x = LobbyJoinRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyJoinRequestResponse) ProtoMessage() {}
+
+func (x *LobbyJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*LobbyJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LobbyJoinRequestResponse) GetRequesterSidx() uint32 {
+	if x != nil {
+		return x.RequesterSidx
+	}
+	return 0
+}
+
+func (x *LobbyJoinRequestResponse) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+// LobbyJoinRequestsResponse lists the pending join requests of the lobby,
+// oldest first.
+type LobbyJoinRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*LobbyJoinRequestResponse `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *LobbyJoinRequestsResponse) Reset() {
+	*x = LobbyJoinRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyJoinRequestsResponse) ProtoMessage() {}
+
+func (x *LobbyJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*LobbyJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LobbyJoinRequestsResponse) GetRequests() []*LobbyJoinRequestResponse {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type LobbySummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyIdx uint32 `protobuf:"varint,1,opt,name=lobby_idx,json=lobbyIdx,proto3" json:"lobby_idx,omitempty"`
+	Mode     uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	HostSidx uint32 `protobuf:"varint,3,opt,name=host_sidx,json=hostSidx,proto3" json:"host_sidx,omitempty"`
+	Players  uint32 `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *LobbySummary) Reset() {
+	*x = LobbySummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbySummary) ProtoMessage() {}
+
+func (x *LobbySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbySummary.ProtoReflect.Descriptor instead.
+func (*LobbySummary) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LobbySummary) GetLobbyIdx() uint32 {
+	if x != nil {
+		return x.LobbyIdx
+	}
+	return 0
+}
+
+func (x *LobbySummary) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *LobbySummary) GetHostSidx() uint32 {
+	if x != nil {
+		return x.HostSidx
+	}
+	return 0
+}
+
+func (x *LobbySummary) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// LobbySearchResponse lists open lobbies with room for another player, joined
+// with RequestJoinLobbyRequest and the host_sidx of the lobby.
+type LobbySearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobbies []*LobbySummary `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
+}
+
+func (x *LobbySearchResponse) Reset() {
+	*x = LobbySearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbySearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbySearchResponse) ProtoMessage() {}
+
+func (x *LobbySearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbySearchResponse.ProtoReflect.Descriptor instead.
+func (*LobbySearchResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LobbySearchResponse) GetLobbies() []*LobbySummary {
+	if x != nil {
+		return x.Lobbies
+	}
+	return nil
+}
+
+// LobbyChatResponse relays a text message, or a quick emote when emote is set,
+// of the lobby member sender_sidx. time is the server clock in unix milliseconds
+// when the message was relayed.
+type LobbyChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderSidx uint32 `protobuf:"varint,1,opt,name=sender_sidx,json=senderSidx,proto3" json:"sender_sidx,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Emote      uint32 `protobuf:"varint,3,opt,name=emote,proto3" json:"emote,omitempty"`
+	Time       int64  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *LobbyChatResponse) Reset() {
+	*x = LobbyChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyChatResponse) ProtoMessage() {}
+
+func (x *LobbyChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyChatResponse.ProtoReflect.Descriptor instead.
+func (*LobbyChatResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LobbyChatResponse) GetSenderSidx() uint32 {
+	if x != nil {
+		return x.SenderSidx
+	}
+	return 0
+}
+
+func (x *LobbyChatResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LobbyChatResponse) GetEmote() uint32 {
+	if x != nil {
+		return x.Emote
+	}
+	return 0
+}
+
+func (x *LobbyChatResponse) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// LobbyChatHistoryResponse carries the latest text messages of the lobby, oldest
+// first, sent to a player after it joins the lobby.
+type LobbyChatHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*LobbyChatResponse `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *LobbyChatHistoryResponse) Reset() {
+	*x = LobbyChatHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyChatHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyChatHistoryResponse) ProtoMessage() {}
+
+func (x *LobbyChatHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyChatHistoryResponse.ProtoReflect.Descriptor instead.
+func (*LobbyChatHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LobbyChatHistoryResponse) GetMessages() []*LobbyChatResponse {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// GameResponse wraps every response message for clients which prefer a single
+// message type. The field numbers match the response codes of the packet format.
+type GameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GameResponse_SyncPos
+	//	*GameResponse_Disconnected
+	//	*GameResponse_Connected
+	//	*GameResponse_Reconnecting
+	//	*GameResponse_CreateLobby
+	//	*GameResponse_JoinLobby
+	//	*GameResponse_Rekey
+	//	*GameResponse_Error
+	//	*GameResponse_Snapshot
+	//	*GameResponse_Reliable
+	//	*GameResponse_LobbyUpdate
+	//	*GameResponse_LobbyInvitation
+	//	*GameResponse_LobbyJoinRequest
+	//	*GameResponse_LobbyInvitationUpdate
+	//	*GameResponse_LobbyInvitations
+	//	*GameResponse_LobbyJoinRequests
+	//	*GameResponse_LobbySearch
+	//	*GameResponse_LobbyChat
+	//	*GameResponse_LobbyChatHistory
+	Response isGameResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GameResponse) Reset() {
+	*x = GameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_response_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResponse) ProtoMessage() {}
+
+func (x *GameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_response_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResponse.ProtoReflect.Descriptor instead.
+func (*GameResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_response_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *GameResponse) GetResponse() isGameResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GameResponse) GetSyncPos() *SyncPosResponse {
+	if x, ok := x.GetResponse().(*GameResponse_SyncPos); ok {
+		return x.SyncPos
+	}
+	return nil
+}
+
+func (x *GameResponse) GetDisconnected() *DisconnectedResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Disconnected); ok {
+		return x.Disconnected
+	}
+	return nil
+}
+
+func (x *GameResponse) GetConnected() *ConnectedResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Connected); ok {
+		return x.Connected
+	}
+	return nil
+}
+
+func (x *GameResponse) GetReconnecting() *ReconnectingResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Reconnecting); ok {
+		return x.Reconnecting
+	}
+	return nil
+}
+
+func (x *GameResponse) GetCreateLobby() *CreateLobbyResponse {
+	if x, ok := x.GetResponse().(*GameResponse_CreateLobby); ok {
+		return x.CreateLobby
+	}
+	return nil
+}
+
+func (x *GameResponse) GetJoinLobby() *JoinLobbyResponse {
+	if x, ok := x.GetResponse().(*GameResponse_JoinLobby); ok {
+		return x.JoinLobby
+	}
+	return nil
+}
+
+func (x *GameResponse) GetRekey() *RekeyResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Rekey); ok {
+		return x.Rekey
+	}
+	return nil
+}
+
+func (x *GameResponse) GetError() *ErrorResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GameResponse) GetSnapshot() *SnapshotResponse {
+	if x, ok := x.GetResponse().(*GameResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GameResponse) GetReliable() *ReliableFrame {
+	if x, ok := x.GetResponse().(*GameResponse_Reliable); ok {
+		return x.Reliable
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyUpdate() *LobbyUpdateResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyUpdate); ok {
+		return x.LobbyUpdate
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyInvitation() *LobbyInvitationResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyInvitation); ok {
+		return x.LobbyInvitation
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyJoinRequest() *LobbyJoinRequestResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyJoinRequest); ok {
+		return x.LobbyJoinRequest
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyInvitationUpdate() *LobbyInvitationUpdateResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyInvitationUpdate); ok {
+		return x.LobbyInvitationUpdate
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyInvitations() *LobbyInvitationsResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyInvitations); ok {
+		return x.LobbyInvitations
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyJoinRequests() *LobbyJoinRequestsResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyJoinRequests); ok {
+		return x.LobbyJoinRequests
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbySearch() *LobbySearchResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbySearch); ok {
+		return x.LobbySearch
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyChat() *LobbyChatResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyChat); ok {
+		return x.LobbyChat
+	}
+	return nil
+}
+
+func (x *GameResponse) GetLobbyChatHistory() *LobbyChatHistoryResponse {
+	if x, ok := x.GetResponse().(*GameResponse_LobbyChatHistory); ok {
+		return x.LobbyChatHistory
+	}
+	return nil
+}
+
+type isGameResponse_Response interface {
+	isGameResponse_Response()
+}
+
+type GameResponse_SyncPos struct {
+	SyncPos *SyncPosResponse `protobuf:"bytes,1,opt,name=sync_pos,json=syncPos,proto3,oneof"`
+}
+
+type GameResponse_Disconnected struct {
+	Disconnected *DisconnectedResponse `protobuf:"bytes,2,opt,name=disconnected,proto3,oneof"`
+}
+
+type GameResponse_Connected struct {
+	Connected *ConnectedResponse `protobuf:"bytes,3,opt,name=connected,proto3,oneof"`
+}
+
+type GameResponse_Reconnecting struct {
+	Reconnecting *ReconnectingResponse `protobuf:"bytes,4,opt,name=reconnecting,proto3,oneof"`
+}
+
+type GameResponse_CreateLobby struct {
+	CreateLobby *CreateLobbyResponse `protobuf:"bytes,5,opt,name=create_lobby,json=createLobby,proto3,oneof"`
+}
+
+type GameResponse_JoinLobby struct {
+	JoinLobby *JoinLobbyResponse `protobuf:"bytes,6,opt,name=join_lobby,json=joinLobby,proto3,oneof"`
+}
+
+type GameResponse_Rekey struct {
+	Rekey *RekeyResponse `protobuf:"bytes,7,opt,name=rekey,proto3,oneof"`
+}
+
+type GameResponse_Error struct {
+	Error *ErrorResponse `protobuf:"bytes,8,opt,name=error,proto3,oneof"`
+}
+
+type GameResponse_Snapshot struct {
+	Snapshot *SnapshotResponse `protobuf:"bytes,9,opt,name=snapshot,proto3,oneof"`
+}
+
+type GameResponse_Reliable struct {
+	Reliable *ReliableFrame `protobuf:"bytes,10,opt,name=reliable,proto3,oneof"`
+}
+
+type GameResponse_LobbyUpdate struct {
+	LobbyUpdate *LobbyUpdateResponse `protobuf:"bytes,11,opt,name=lobby_update,json=lobbyUpdate,proto3,oneof"`
+}
+
+type GameResponse_LobbyInvitation struct {
+	LobbyInvitation *LobbyInvitationResponse `protobuf:"bytes,12,opt,name=lobby_invitation,json=lobbyInvitation,proto3,oneof"`
+}
+
+type GameResponse_LobbyJoinRequest struct {
+	LobbyJoinRequest *LobbyJoinRequestResponse `protobuf:"bytes,13,opt,name=lobby_join_request,json=lobbyJoinRequest,proto3,oneof"`
+}
+
+type GameResponse_LobbyInvitationUpdate struct {
+	LobbyInvitationUpdate *LobbyInvitationUpdateResponse `protobuf:"bytes,14,opt,name=lobby_invitation_update,json=lobbyInvitationUpdate,proto3,oneof"`
+}
+
+type GameResponse_LobbyInvitations struct {
+	LobbyInvitations *LobbyInvitationsResponse `protobuf:"bytes,15,opt,name=lobby_invitations,json=lobbyInvitations,proto3,oneof"`
+}
+
+type GameResponse_LobbyJoinRequests struct {
+	LobbyJoinRequests *LobbyJoinRequestsResponse `protobuf:"bytes,16,opt,name=lobby_join_requests,json=lobbyJoinRequests,proto3,oneof"`
+}
+
+type GameResponse_LobbySearch struct {
+	LobbySearch *LobbySearchResponse `protobuf:"bytes,17,opt,name=lobby_search,json=lobbySearch,proto3,oneof"`
+}
+
+type GameResponse_LobbyChat struct {
+	LobbyChat *LobbyChatResponse `protobuf:"bytes,18,opt,name=lobby_chat,json=lobbyChat,proto3,oneof"`
+}
+
+type GameResponse_LobbyChatHistory struct {
+	LobbyChatHistory *LobbyChatHistoryResponse `protobuf:"bytes,19,opt,name=lobby_chat_history,json=lobbyChatHistory,proto3,oneof"`
+}
+
+func (*GameResponse_SyncPos) isGameResponse_Response() {}
+
+func (*GameResponse_Disconnected) isGameResponse_Response() {}
+
+func (*GameResponse_Connected) isGameResponse_Response() {}
+
+func (*GameResponse_Reconnecting) isGameResponse_Response() {}
+
+func (*GameResponse_CreateLobby) isGameResponse_Response() {}
+
+func (*GameResponse_JoinLobby) isGameResponse_Response() {}
+
+func (*GameResponse_Rekey) isGameResponse_Response() {}
+
+func (*GameResponse_Error) isGameResponse_Response() {}
+
+func (*GameResponse_Snapshot) isGameResponse_Response() {}
+
+func (*GameResponse_Reliable) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyUpdate) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyInvitation) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyJoinRequest) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyInvitationUpdate) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyInvitations) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyJoinRequests) isGameResponse_Response() {}
+
+func (*GameResponse_LobbySearch) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyChat) isGameResponse_Response() {}
+
+func (*GameResponse_LobbyChatHistory) isGameResponse_Response() {}
+
 var File_protobuf_game_response_proto protoreflect.FileDescriptor
 
 var file_protobuf_game_response_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f,
 	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x2d, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08,
+	0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4a, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x22, 0x63, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x64, 0x78,
+	0x22, 0x80, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x73, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x53, 0x69, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69,
+	0x64, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x69, 0x64, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x5b, 0x0a, 0x0d, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x2d, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08,
+	0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x69, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x53,
+	0x69, 0x64, 0x78, 0x22, 0x93, 0x02, 0x0a, 0x13, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x64, 0x78, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x06, 0x67, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x1d, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x53, 0x69, 0x64, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x78, 0x22, 0x5f, 0x0a, 0x18,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5e, 0x0a,
+	0x18, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x78, 0x22, 0x5b, 0x0a,
+	0x19, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x69, 0x64,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x53, 0x0a, 0x18, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0xbb, 0x0a, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x12, 0x44, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x6a, 0x6f,
+	0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6a,
+	0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x65, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x4e, 0x0a, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x52, 0x0a, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x17, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x15, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x13, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x42, 0x0a, 0x0c, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x63, 0x68,
+	0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68,
+	0x61, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43,
+	0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x6e, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x9a, 0x01, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x2a,
+	0x9b, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x42, 0x42, 0x59,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a,
+	0x15, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53,
+	0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x4f, 0x42, 0x42,
+	0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x05, 0x12, 0x14,
+	0x0a, 0x10, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x4f,
+	0x53, 0x54, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x4c,
+	0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x10, 0x09, 0x12, 0x1d,
+	0x0a, 0x19, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x4f,
+	0x53, 0x54, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x2a, 0xb1, 0x01,
+	0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_game_response_proto_rawDescOnce sync.Once
+	file_protobuf_game_response_proto_rawDescData = file_protobuf_game_response_proto_rawDesc
+)
+
+func file_protobuf_game_response_proto_rawDescGZIP() []byte {
+	file_protobuf_game_response_proto_rawDescOnce.Do(func() {
+		file_protobuf_game_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_game_response_proto_rawDescData)
+	})
+	return file_protobuf_game_response_proto_rawDescData
 }
 
-var file_protobuf_game_response_proto_goTypes = []interface{}{}
+var file_protobuf_game_response_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_protobuf_game_response_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_protobuf_game_response_proto_goTypes = []interface{}{
+	(DisconnectReason)(0),                 // 0: protobuf.DisconnectReason
+	(ErrorCode)(0),                        // 1: protobuf.ErrorCode
+	(LobbyEvent)(0),                       // 2: protobuf.LobbyEvent
+	(InvitationStatus)(0),                 // 3: protobuf.InvitationStatus
+	(*SyncPosResponse)(nil),               // 4: protobuf.SyncPosResponse
+	(*DisconnectedResponse)(nil),          // 5: protobuf.DisconnectedResponse
+	(*ConnectedResponse)(nil),             // 6: protobuf.ConnectedResponse
+	(*ReconnectingResponse)(nil),          // 7: protobuf.ReconnectingResponse
+	(*CreateLobbyResponse)(nil),           // 8: protobuf.CreateLobbyResponse
+	(*JoinLobbyResponse)(nil),             // 9: protobuf.JoinLobbyResponse
+	(*RekeyResponse)(nil),                 // 10: protobuf.RekeyResponse
+	(*ErrorResponse)(nil),                 // 11: protobuf.ErrorResponse
+	(*PlayerDelta)(nil),                   // 12: protobuf.PlayerDelta
+	(*SnapshotResponse)(nil),              // 13: protobuf.SnapshotResponse
+	(*LobbyMember)(nil),                   // 14: protobuf.LobbyMember
+	(*LobbyUpdateResponse)(nil),           // 15: protobuf.LobbyUpdateResponse
+	(*LobbyInvitationResponse)(nil),       // 16: protobuf.LobbyInvitationResponse
+	(*LobbyInvitationUpdateResponse)(nil), // 17: protobuf.LobbyInvitationUpdateResponse
+	(*LobbyInvitationsResponse)(nil),      // 18: protobuf.LobbyInvitationsResponse
+	(*LobbyJoinRequestResponse)(nil),      // 19: protobuf.LobbyJoinRequestResponse
+	(*LobbyJoinRequestsResponse)(nil),     // 20: protobuf.LobbyJoinRequestsResponse
+	(*LobbySummary)(nil),                  // 21: protobuf.LobbySummary
+	(*LobbySearchResponse)(nil),           // 22: protobuf.LobbySearchResponse
+	(*LobbyChatResponse)(nil),             // 23: protobuf.LobbyChatResponse
+	(*LobbyChatHistoryResponse)(nil),      // 24: protobuf.LobbyChatHistoryResponse
+	(*GameResponse)(nil),                  // 25: protobuf.GameResponse
+	(*Vector3)(nil),                       // 26: protobuf.Vector3
+	(*Quaternion)(nil),                    // 27: protobuf.Quaternion
+	(LobbyPrivacy)(0),                     // 28: protobuf.LobbyPrivacy
+	(*ReliableFrame)(nil),                 // 29: protobuf.ReliableFrame
+}
 var file_protobuf_game_response_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	26, // 0: protobuf.SyncPosResponse.position:type_name -> protobuf.Vector3
+	27, // 1: protobuf.SyncPosResponse.rotation:type_name -> protobuf.Quaternion
+	26, // 2: protobuf.SyncPosResponse.velocity:type_name -> protobuf.Vector3
+	0,  // 3: protobuf.DisconnectedResponse.reason:type_name -> protobuf.DisconnectReason
+	1,  // 4: protobuf.ErrorResponse.code:type_name -> protobuf.ErrorCode
+	26, // 5: protobuf.PlayerDelta.position:type_name -> protobuf.Vector3
+	27, // 6: protobuf.PlayerDelta.rotation:type_name -> protobuf.Quaternion
+	26, // 7: protobuf.PlayerDelta.velocity:type_name -> protobuf.Vector3
+	12, // 8: protobuf.SnapshotResponse.players:type_name -> protobuf.PlayerDelta
+	2,  // 9: protobuf.LobbyUpdateResponse.event:type_name -> protobuf.LobbyEvent
+	14, // 10: protobuf.LobbyUpdateResponse.guests:type_name -> protobuf.LobbyMember
+	28, // 11: protobuf.LobbyUpdateResponse.privacy:type_name -> protobuf.LobbyPrivacy
+	3,  // 12: protobuf.LobbyInvitationUpdateResponse.status:type_name -> protobuf.InvitationStatus
+	16, // 13: protobuf.LobbyInvitationsResponse.invitations:type_name -> protobuf.LobbyInvitationResponse
+	19, // 14: protobuf.LobbyJoinRequestsResponse.requests:type_name -> protobuf.LobbyJoinRequestResponse
+	21, // 15: protobuf.LobbySearchResponse.lobbies:type_name -> protobuf.LobbySummary
+	23, // 16: protobuf.LobbyChatHistoryResponse.messages:type_name -> protobuf.LobbyChatResponse
+	4,  // 17: protobuf.GameResponse.sync_pos:type_name -> protobuf.SyncPosResponse
+	5,  // 18: protobuf.GameResponse.disconnected:type_name -> protobuf.DisconnectedResponse
+	6,  // 19: protobuf.GameResponse.connected:type_name -> protobuf.ConnectedResponse
+	7,  // 20: protobuf.GameResponse.reconnecting:type_name -> protobuf.ReconnectingResponse
+	8,  // 21: protobuf.GameResponse.create_lobby:type_name -> protobuf.CreateLobbyResponse
+	9,  // 22: protobuf.GameResponse.join_lobby:type_name -> protobuf.JoinLobbyResponse
+	10, // 23: protobuf.GameResponse.rekey:type_name -> protobuf.RekeyResponse
+	11, // 24: protobuf.GameResponse.error:type_name -> protobuf.ErrorResponse
+	13, // 25: protobuf.GameResponse.snapshot:type_name -> protobuf.SnapshotResponse
+	29, // 26: protobuf.GameResponse.reliable:type_name -> protobuf.ReliableFrame
+	15, // 27: protobuf.GameResponse.lobby_update:type_name -> protobuf.LobbyUpdateResponse
+	16, // 28: protobuf.GameResponse.lobby_invitation:type_name -> protobuf.LobbyInvitationResponse
+	19, // 29: protobuf.GameResponse.lobby_join_request:type_name -> protobuf.LobbyJoinRequestResponse
+	17, // 30: protobuf.GameResponse.lobby_invitation_update:type_name -> protobuf.LobbyInvitationUpdateResponse
+	18, // 31: protobuf.GameResponse.lobby_invitations:type_name -> protobuf.LobbyInvitationsResponse
+	20, // 32: protobuf.GameResponse.lobby_join_requests:type_name -> protobuf.LobbyJoinRequestsResponse
+	22, // 33: protobuf.GameResponse.lobby_search:type_name -> protobuf.LobbySearchResponse
+	23, // 34: protobuf.GameResponse.lobby_chat:type_name -> protobuf.LobbyChatResponse
+	24, // 35: protobuf.GameResponse.lobby_chat_history:type_name -> protobuf.LobbyChatHistoryResponse
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_game_response_proto_init() }
@@ -42,18 +2319,308 @@ func file_protobuf_game_response_proto_init() {
 	if File_protobuf_game_response_proto != nil {
 		return
 	}
+	file_protobuf_game_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_game_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncPosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconnectingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitationUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyJoinRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyJoinRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyChatHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_response_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_protobuf_game_response_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*GameResponse_SyncPos)(nil),
+		(*GameResponse_Disconnected)(nil),
+		(*GameResponse_Connected)(nil),
+		(*GameResponse_Reconnecting)(nil),
+		(*GameResponse_CreateLobby)(nil),
+		(*GameResponse_JoinLobby)(nil),
+		(*GameResponse_Rekey)(nil),
+		(*GameResponse_Error)(nil),
+		(*GameResponse_Snapshot)(nil),
+		(*GameResponse_Reliable)(nil),
+		(*GameResponse_LobbyUpdate)(nil),
+		(*GameResponse_LobbyInvitation)(nil),
+		(*GameResponse_LobbyJoinRequest)(nil),
+		(*GameResponse_LobbyInvitationUpdate)(nil),
+		(*GameResponse_LobbyInvitations)(nil),
+		(*GameResponse_LobbyJoinRequests)(nil),
+		(*GameResponse_LobbySearch)(nil),
+		(*GameResponse_LobbyChat)(nil),
+		(*GameResponse_LobbyChatHistory)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_game_response_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   0,
+			NumEnums:      4,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_protobuf_game_response_proto_goTypes,
 		DependencyIndexes: file_protobuf_game_response_proto_depIdxs,
+		EnumInfos:         file_protobuf_game_response_proto_enumTypes,
+		MessageInfos:      file_protobuf_game_response_proto_msgTypes,
 	}.Build()
 	File_protobuf_game_response_proto = out.File
 	file_protobuf_game_response_proto_rawDesc = nil
@@ -0,0 +1,505 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.19.4
+// source: protobuf/game_types.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LobbyPrivacy selects who may join a lobby. Invitations are accepted unless the
+// lobby is closed, join requests are left to the host on host-approval lobbies,
+// the default, rejected on invite-only and closed lobbies, left to the host on
+// friends-of-members lobbies when the requester is a friend of a member, and
+// accepted right away on open lobbies. Without a friendship source configured on
+// the server, nobody is a friend and friends-of-members lobbies reject every join
+// request.
+type LobbyPrivacy int32
+
+const (
+	LobbyPrivacy_LOBBY_PRIVACY_HOST_APPROVAL LobbyPrivacy = 0
+	LobbyPrivacy_LOBBY_PRIVACY_INVITE_ONLY   LobbyPrivacy = 1
+	LobbyPrivacy_LOBBY_PRIVACY_CLOSED        LobbyPrivacy = 2
+	LobbyPrivacy_LOBBY_PRIVACY_FRIENDS       LobbyPrivacy = 3
+	LobbyPrivacy_LOBBY_PRIVACY_OPEN          LobbyPrivacy = 4
+)
+
+// Enum value maps for LobbyPrivacy.
+var (
+	LobbyPrivacy_name = map[int32]string{
+		0: "LOBBY_PRIVACY_HOST_APPROVAL",
+		1: "LOBBY_PRIVACY_INVITE_ONLY",
+		2: "LOBBY_PRIVACY_CLOSED",
+		3: "LOBBY_PRIVACY_FRIENDS",
+		4: "LOBBY_PRIVACY_OPEN",
+	}
+	LobbyPrivacy_value = map[string]int32{
+		"LOBBY_PRIVACY_HOST_APPROVAL": 0,
+		"LOBBY_PRIVACY_INVITE_ONLY":   1,
+		"LOBBY_PRIVACY_CLOSED":        2,
+		"LOBBY_PRIVACY_FRIENDS":       3,
+		"LOBBY_PRIVACY_OPEN":          4,
+	}
+)
+
+func (x LobbyPrivacy) Enum() *LobbyPrivacy {
+	p := new(LobbyPrivacy)
+	*p = x
+	return p
+}
+
+func (x LobbyPrivacy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyPrivacy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_game_types_proto_enumTypes[0].Descriptor()
+}
+
+func (LobbyPrivacy) Type() protoreflect.EnumType {
+	return &file_protobuf_game_types_proto_enumTypes[0]
+}
+
+func (x LobbyPrivacy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyPrivacy.Descriptor instead.
+func (LobbyPrivacy) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_game_types_proto_rawDescGZIP(), []int{0}
+}
+
+type Vector3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float32 `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *Vector3) Reset() {
+	*x = Vector3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vector3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector3) ProtoMessage() {}
+
+func (x *Vector3) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector3.ProtoReflect.Descriptor instead.
+func (*Vector3) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vector3) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector3) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vector3) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type Quaternion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float32 `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	W float32 `protobuf:"fixed32,4,opt,name=w,proto3" json:"w,omitempty"`
+}
+
+func (x *Quaternion) Reset() {
+	*x = Quaternion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quaternion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quaternion) ProtoMessage() {}
+
+func (x *Quaternion) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quaternion.ProtoReflect.Descriptor instead.
+func (*Quaternion) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Quaternion) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Quaternion) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Quaternion) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *Quaternion) GetW() float32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+// ReliableMessage carries a request or response delivered reliably and in order
+// within its channel. payload is the protobuf data of the code.
+type ReliableMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence uint32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Code     uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Payload  []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ReliableMessage) Reset() {
+	*x = ReliableMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReliableMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliableMessage) ProtoMessage() {}
+
+func (x *ReliableMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliableMessage.ProtoReflect.Descriptor instead.
+func (*ReliableMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReliableMessage) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ReliableMessage) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReliableMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// ReliableFrame carries reliable messages of one channel along with the
+// acknowledgement of the messages received from the peer on that channel:
+// ack is the highest sequence received, and bit i of ack_bits is set if the
+// sequence ack-1-i has been received too.
+type ReliableFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel  uint32             `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Messages []*ReliableMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Ack      uint32             `protobuf:"varint,3,opt,name=ack,proto3" json:"ack,omitempty"`
+	AckBits  uint64             `protobuf:"varint,4,opt,name=ack_bits,json=ackBits,proto3" json:"ack_bits,omitempty"`
+}
+
+func (x *ReliableFrame) Reset() {
+	*x = ReliableFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_game_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReliableFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliableFrame) ProtoMessage() {}
+
+func (x *ReliableFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_game_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliableFrame.ProtoReflect.Descriptor instead.
+func (*ReliableFrame) Descriptor() ([]byte, []int) {
+	return file_protobuf_game_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReliableFrame) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *ReliableFrame) GetMessages() []*ReliableMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ReliableFrame) GetAck() uint32 {
+	if x != nil {
+		return x.Ack
+	}
+	return 0
+}
+
+func (x *ReliableFrame) GetAckBits() uint64 {
+	if x != nil {
+		return x.AckBits
+	}
+	return 0
+}
+
+var File_protobuf_game_types_proto protoreflect.FileDescriptor
+
+var file_protobuf_game_types_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x22, 0x33, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x44, 0x0a, 0x0a, 0x51, 0x75,
+	0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x01, 0x7a, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x77,
+	0x22, 0x5b, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8d, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61,
+	0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x6b, 0x42, 0x69, 0x74, 0x73, 0x2a, 0x9b, 0x01,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x1f,
+	0x0a, 0x1b, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f,
+	0x48, 0x4f, 0x53, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59,
+	0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x42, 0x42,
+	0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44,
+	0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x50, 0x52, 0x49,
+	0x56, 0x41, 0x43, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x04, 0x42, 0x0c, 0x5a, 0x0a, 0x2e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_protobuf_game_types_proto_rawDescOnce sync.Once
+	file_protobuf_game_types_proto_rawDescData = file_protobuf_game_types_proto_rawDesc
+)
+
+func file_protobuf_game_types_proto_rawDescGZIP() []byte {
+	file_protobuf_game_types_proto_rawDescOnce.Do(func() {
+		file_protobuf_game_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_game_types_proto_rawDescData)
+	})
+	return file_protobuf_game_types_proto_rawDescData
+}
+
+var file_protobuf_game_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protobuf_game_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_protobuf_game_types_proto_goTypes = []interface{}{
+	(LobbyPrivacy)(0),       // 0: protobuf.LobbyPrivacy
+	(*Vector3)(nil),         // 1: protobuf.Vector3
+	(*Quaternion)(nil),      // 2: protobuf.Quaternion
+	(*ReliableMessage)(nil), // 3: protobuf.ReliableMessage
+	(*ReliableFrame)(nil),   // 4: protobuf.ReliableFrame
+}
+var file_protobuf_game_types_proto_depIdxs = []int32{
+	3, // 0: protobuf.ReliableFrame.messages:type_name -> protobuf.ReliableMessage
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_game_types_proto_init() }
+func file_protobuf_game_types_proto_init() {
+	if File_protobuf_game_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_game_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vector3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quaternion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReliableMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_game_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReliableFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protobuf_game_types_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protobuf_game_types_proto_goTypes,
+		DependencyIndexes: file_protobuf_game_types_proto_depIdxs,
+		EnumInfos:         file_protobuf_game_types_proto_enumTypes,
+		MessageInfos:      file_protobuf_game_types_proto_msgTypes,
+	}.Build()
+	File_protobuf_game_types_proto = out.File
+	file_protobuf_game_types_proto_rawDesc = nil
+	file_protobuf_game_types_proto_goTypes = nil
+	file_protobuf_game_types_proto_depIdxs = nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -15,29 +16,56 @@ import (
 
 const version uint8 = 1
 
+// defaultMaxRequestSize is the default maximum size of the AuthRequest of a
+// login request frame, enough for a JWT signed with an RSA 4096 key along with
+// the client public key and reconnect token.
+const defaultMaxRequestSize = 8 << 10
+
 type Config struct {
-	Address         string
-	TlsCertFile     string
-	TlsKeyFile      string
-	QueueCapacity   int
-	QueueBufferSize int
-	NbWorkers       int
-	ReadTimeout     time.Duration
-	WriteTimeout    time.Duration
+	Address            string
+	TlsCertFile        string
+	TlsKeyFile         string
+	JwksFile           string
+	JwksReloadInterval time.Duration
+	QueueCapacity      int
+	MaxRequestSize     int // maximum size of the AuthRequest of a login request frame
+	NbWorkers          int
+	ReadTimeout        time.Duration
+	WriteTimeout       time.Duration
+	Verifiers          []IdentityVerifier
+	ConflictPolicy     ConflictPolicy
+	OnKick             func(s *server.Session) // called after a session is kicked by ConflictKick
+	OnResume           func(s *server.Session) // called after a session is resumed by ConflictResume
 }
 
 // RunAuthServer starts the authentication server with the provided configuration.
-// It loads the TLS certificate and key pair from the specified files, creates a TLS listener
-// on the given address, and spawns worker goroutines to handle incoming connections.
+// It loads the token verification keys from the specified JWKS file, reloading it
+// on change when a reload interval is set until ctx is done, loads the TLS
+// certificate and key pair from the specified files, creates a TLS listener on
+// the given address, and spawns worker goroutines to handle incoming connections.
 //
 // Parameters:
 //
+//	ctx (context.Context): The context which stops the JWKS file reload when done.
 //	c (Config): The configuration for the authentication server.
 //
 // Returns:
 //
 //	error: An error if any occurred during server setup, otherwise nil.
-func RunAuthServer(c Config) error {
+func RunAuthServer(ctx context.Context, c Config) error {
+	idps, err := newVerifierMap(c.Verifiers)
+	if err != nil {
+		return err
+	}
+	if c.MaxRequestSize <= 0 {
+		c.MaxRequestSize = defaultMaxRequestSize
+	}
+
+	jwks, err := loadKeySetFile(ctx, c.JwksFile, c.JwksReloadInterval)
+	if err != nil {
+		return err
+	}
+
 	cert, err := tls.LoadX509KeyPair(c.TlsCertFile, c.TlsKeyFile)
 	if err != nil {
 		return err
@@ -54,7 +82,7 @@ func RunAuthServer(c Config) error {
 	chc := make(chan net.Conn, c.QueueCapacity)
 	go director(server, chc)
 	for i := 0; i < c.NbWorkers; i++ {
-		go handler(chc, c)
+		go handler(chc, c, idps, jwks)
 	}
 
 	return nil
@@ -85,9 +113,10 @@ func director(server net.Listener, chc chan net.Conn) {
 // Parameters:
 //
 //	chc (chan net.Conn): The channel from which connections are received.
-//	bufferSize (int): The size of the buffer used for reading data from connections.
-//	readTimeout (time.Duration): The timeout for read operations on connections.
-func handler(chc chan net.Conn, c Config) {
+//	c (Config): The configuration for the authentication server.
+//	idps (verifierMap): The identity verifiers keyed by their identity provider.
+//	jwks (*keySetFile): The JWKS file holding the token verification keys.
+func handler(chc chan net.Conn, c Config, idps verifierMap, jwks *keySetFile) {
 	for conn := range chc {
 		var err error
 
@@ -105,52 +134,57 @@ func handler(chc chan net.Conn, c Config) {
 			continue
 		}
 
-		// Read auth login request from the client.
-		b := make([]byte, c.QueueBufferSize)
-		n, err := conn.Read(b)
+		// Read exactly one auth login request frame from the client.
+		req, err := readLoginRequest(conn, c.MaxRequestSize)
 		if err != nil {
 			if errors.Is(err, os.ErrDeadlineExceeded) {
 				close(conn, responseLoginTimeout)
 				continue
 			} else {
-				close(conn, responseInternalError)
+				close(conn, responseInvalidRequest)
 				continue
 			}
 		}
 
+		// Resolve the requested protocol version.
+		new, ok := newSessionFunc(req)
+		if !ok {
+			close(conn, responseInvalidRequest)
+			continue
+		}
+
 		// Verify client auth token and claims.
-		s := string(b[:n])
-		c := VerifyAuthClaims(s)
-		if c == nil {
+		claims := VerifyAuthClaims(req.Token, jwks.KeySet())
+		if claims == nil {
 			close(conn, responseInvalidToken)
 			continue
 		}
 
 		// Ensure the claims match with this server.
-		ok := VerifyServerMatch(c.IdProvider, c.AppId)
+		ok = idps.VerifyServerMatch(claims)
 		if !ok {
 			close(conn, responseInvalidServer)
 			continue
 		}
 
-		// Ensure no session is associated with this user
-		if server.SharedSession().GetFromUid(c.Uid) != nil {
-			close(conn, responseLoginConflict)
-			continue
-		}
-
-		// Create a new session for this user id
-		new := server.NewSessionV1
-		session := server.SharedSession().NewSession(new, c.Uid)
+		// Create a new session for this user id, or resolve the session
+		// already associated with this user id.
+		session, code := login(c, req, claims.Uid, new)
 		if session == nil {
-			close(conn, responseInternalError)
+			close(conn, code)
 			continue
 		}
 
-		// Write response for login success
+		// Write response for login success. The session key is only sent on
+		// version 1, version 2 clients derive it from the server public key.
 		r := &protobuf.AuthResponseLoginSuccess{
-			Sidx:      session.Sidx,
-			Aes256Key: session.SharedKey[:],
+			Sidx:           uint32(session.Sidx),
+			ReconnectToken: session.ReconnectToken[:],
+		}
+		if session.Version == 1 {
+			r.Aes256Key = session.SharedKey[:]
+		} else {
+			r.X25519PublicKey = session.PublicKey[:]
 		}
 
 		p, err := proto.Marshal(r)
@@ -159,9 +193,9 @@ func handler(chc chan net.Conn, c Config) {
 			continue
 		}
 
-		b = b[:0]
+		b := make([]byte, 0, 2+len(p))
 		b = append(b, version)
-		b = append(b, responseLoginSuccess)
+		b = append(b, code)
 		b = append(b, p...)
 		conn.Write(b)
 		conn.Close()
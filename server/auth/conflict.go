@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+)
+
+// ConflictPolicy determines how a login is handled when a session is already
+// associated with the user.
+type ConflictPolicy uint8
+
+const (
+	// ConflictReject rejects the login and keeps the existing session.
+	ConflictReject ConflictPolicy = iota
+
+	// ConflictKick terminates the existing session and creates a new one.
+	ConflictKick
+
+	// ConflictResume resumes the existing session with a rotated key, keeping
+	// its session index (sidx) and game state, only if the client proves
+	// possession of the session reconnect token. Otherwise, the login is rejected.
+	ConflictResume
+)
+
+// login creates the session for the provided user ID, resolving any session
+// already associated with the user according to the configured ConflictPolicy.
+//
+// Parameters:
+//   - c: The configuration for the authentication server.
+//   - req: The auth request sent by the client.
+//   - uid: The verified user ID.
+//   - new: A function for creating the session.
+//
+// Returns:
+//   - *server.Session: A pointer to the created or resumed session, or nil if
+//     the login fails.
+//   - response: The response code to be sent to the client.
+func login(c Config, req *protobuf.AuthRequest, uid uint, new server.NewSession) (*server.Session, response) {
+	sc := server.SharedSession()
+
+	if old := sc.GetFromUid(uid); old != nil {
+		switch c.ConflictPolicy {
+		case ConflictKick:
+			if sc.Expire(old) && c.OnKick != nil {
+				c.OnKick(old)
+			}
+
+		case ConflictResume:
+			if !old.VerifyReconnectToken(req.ReconnectToken) {
+				return nil, responseLoginConflict
+			}
+			s := sc.Resume(old, new)
+			if s == nil {
+				return nil, responseInternalError
+			}
+			if c.OnResume != nil {
+				c.OnResume(s)
+			}
+			return s, responseLoginResumed
+
+		default:
+			return nil, responseLoginConflict
+		}
+	}
+
+	s := sc.NewSession(new, uid)
+	if s == nil {
+		// A concurrent login of the same user has registered its session first.
+		if sc.GetFromUid(uid) != nil {
+			return nil, responseLoginConflict
+		}
+		return nil, responseInternalError
+	}
+	return s, responseLoginSuccess
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+)
+
+// removeUid removes the session of the provided user id from the shared session
+// container, whether it is the session created by the test or one replacing it.
+func removeUid(uid uint) {
+	if s := server.SharedSession().GetFromUid(uid); s != nil {
+		server.SharedSession().Remove(s.Sidx)
+	}
+}
+
+func TestLoginConflict(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy ConflictPolicy
+		token  func(old *server.Session) []byte
+		want   response
+	}{
+		{"Reject", ConflictReject, validToken, responseLoginConflict},
+		{"Kick", ConflictKick, nil, responseLoginSuccess},
+		{"Resume", ConflictResume, validToken, responseLoginResumed},
+		{"ResumeBad", ConflictResume, badToken, responseLoginConflict},
+		{"ResumeShort", ConflictResume, shortToken, responseLoginConflict},
+		{"ResumeNone", ConflictResume, nil, responseLoginConflict},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid := uint(i + 1)
+			old := server.SharedSession().NewSession(server.NewSessionV1, uid)
+			defer removeUid(uid)
+			var kicked, resumed *server.Session
+			c := Config{
+				ConflictPolicy: tt.policy,
+				OnKick:         func(s *server.Session) { kicked = s },
+				OnResume:       func(s *server.Session) { resumed = s },
+			}
+			req := &protobuf.AuthRequest{}
+			if tt.token != nil {
+				req.ReconnectToken = tt.token(old)
+			}
+
+			s, code := login(c, req, old.Uid, server.NewSessionV1)
+			if code != tt.want {
+				t.Fatalf("got response %d, want %d", code, tt.want)
+			}
+			current := server.SharedSession().Get(old.Sidx)
+
+			switch code {
+			case responseLoginConflict:
+				if s != nil || current != old {
+					t.Fatal("rejected login replaced the existing session")
+				}
+			case responseLoginSuccess:
+				if kicked != old || current != nil {
+					t.Fatal("existing session not kicked")
+				}
+				if s == nil || server.SharedSession().GetFromUid(old.Uid) != s {
+					t.Fatal("new session not registered")
+				}
+			case responseLoginResumed:
+				if s == nil || resumed != s || current != s || s.Sidx != old.Sidx {
+					t.Fatal("session not resumed under the same sidx")
+				}
+				if s.SharedKey == old.SharedKey || s.ReconnectToken == old.ReconnectToken {
+					t.Fatal("resumed session kept the previous key or token")
+				}
+			}
+			if code != responseLoginSuccess && kicked != nil {
+				t.Fatal("existing session kicked")
+			}
+		})
+	}
+}
+
+func TestLoginResumeStaleToken(t *testing.T) {
+	old := server.SharedSession().NewSession(server.NewSessionV1, 100)
+	defer removeUid(old.Uid)
+	c := Config{ConflictPolicy: ConflictResume}
+	req := &protobuf.AuthRequest{ReconnectToken: validToken(old)}
+
+	s, code := login(c, req, old.Uid, server.NewSessionV1)
+	if code != responseLoginResumed {
+		t.Fatalf("got response %d, want %d", code, responseLoginResumed)
+	}
+
+	// The token of the replaced session is rotated out by the resume.
+	if _, code := login(c, req, old.Uid, server.NewSessionV1); code != responseLoginConflict {
+		t.Fatalf("stale token got response %d, want %d", code, responseLoginConflict)
+	}
+	if server.SharedSession().Get(s.Sidx) != s {
+		t.Fatal("stale token replaced the resumed session")
+	}
+}
+
+func TestLoginResumeExpired(t *testing.T) {
+	old := server.SharedSession().NewSession(server.NewSessionV1, 101)
+	defer removeUid(old.Uid)
+	if !server.SharedSession().Expire(old) {
+		t.Fatal("failed to expire session")
+	}
+	if server.SharedSession().Resume(old, server.NewSessionV1) != nil {
+		t.Fatal("resumed a session whose slot has been released")
+	}
+
+	// With the slot released, the login creates a fresh session instead.
+	c := Config{ConflictPolicy: ConflictResume}
+	req := &protobuf.AuthRequest{ReconnectToken: validToken(old)}
+	s, code := login(c, req, old.Uid, server.NewSessionV1)
+	if code != responseLoginSuccess {
+		t.Fatalf("got response %d, want %d", code, responseLoginSuccess)
+	}
+	if s.Sidx == old.Sidx || server.SharedSession().Get(old.Sidx) != nil {
+		t.Fatal("expired session handle resolved again")
+	}
+}
+
+func validToken(s *server.Session) []byte {
+	return append([]byte(nil), s.ReconnectToken[:]...)
+}
+
+func badToken(s *server.Session) []byte {
+	b := validToken(s)
+	b[len(b)-1] ^= 1
+	return b
+}
+
+func shortToken(s *server.Session) []byte {
+	return validToken(s)[:16]
+}
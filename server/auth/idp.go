@@ -1,5 +1,10 @@
 package auth
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Constants representing various identity providers (IDPs).
 const (
 	ANONYMOUS_IDP_STRING  string = "anonymous"
@@ -13,33 +18,120 @@ const (
 	STEAM_IDP_STRING      string = "steam"
 )
 
-// mapIdp is a map that stores the association between identity providers (IDPs)
-// and their corresponding registered application IDs.
-var mapIdp map[string]string
+// IdentityVerifier verifies the identity provider (IDP) specific claims of an
+// auth token whose signature has already been verified. Each verifier handles
+// exactly one IDP and is selected by the token's idp claim.
+type IdentityVerifier interface {
+	// IdProvider returns the identity provider (IDP) string handled by this verifier.
+	IdProvider() string
+
+	// Verify reports whether the provided claims are issued for this server.
+	Verify(c *AuthClaims) bool
+}
+
+// AnonymousVerifier accepts anonymous tokens which carry no application ID.
+type AnonymousVerifier struct{}
+
+func (AnonymousVerifier) IdProvider() string        { return ANONYMOUS_IDP_STRING }
+func (AnonymousVerifier) Verify(c *AuthClaims) bool { return c.AppId == "" }
+
+// AppleVerifier accepts Apple tokens issued for the registered bundle ID.
+type AppleVerifier struct{ AppId string }
+
+func (v AppleVerifier) IdProvider() string        { return APPLE_IDP_STRING }
+func (v AppleVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// EpicGamesVerifier accepts Epic Games tokens issued for the registered client ID.
+type EpicGamesVerifier struct{ AppId string }
+
+func (v EpicGamesVerifier) IdProvider() string        { return EPIC_GAMES_IDP_STRING }
+func (v EpicGamesVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// FacebookVerifier accepts Facebook tokens issued for the registered app ID.
+type FacebookVerifier struct{ AppId string }
+
+func (v FacebookVerifier) IdProvider() string        { return FACEBOOK_IDP_STRING }
+func (v FacebookVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// GoogleVerifier accepts Google tokens issued for the registered client ID.
+type GoogleVerifier struct{ AppId string }
+
+func (v GoogleVerifier) IdProvider() string        { return GOOGLE_IDP_STRING }
+func (v GoogleVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// MicrosoftVerifier accepts Microsoft tokens issued for the registered title ID.
+type MicrosoftVerifier struct{ AppId string }
+
+func (v MicrosoftVerifier) IdProvider() string        { return MICROSOFT_IDP_STRING }
+func (v MicrosoftVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
 
-// init initializes the mapIdp with the registered application IDs for specific
-// identity providers.
-func init() {
-	mapIdp = make(map[string]string)
-	mapIdp[ANONYMOUS_IDP_STRING] = ""
-	mapIdp[STEAM_IDP_STRING] = "480"
+// NintendoVerifier accepts Nintendo tokens issued for the registered application ID.
+type NintendoVerifier struct{ AppId string }
+
+func (v NintendoVerifier) IdProvider() string        { return NINTENTDO_IDP_STRING }
+func (v NintendoVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// PsnVerifier accepts PlayStation Network tokens issued for the registered client ID.
+type PsnVerifier struct{ AppId string }
+
+func (v PsnVerifier) IdProvider() string        { return PSN_IDP_STRING }
+func (v PsnVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// SteamVerifier accepts Steam tokens issued for the registered app ID.
+type SteamVerifier struct{ AppId string }
+
+func (v SteamVerifier) IdProvider() string        { return STEAM_IDP_STRING }
+func (v SteamVerifier) Verify(c *AuthClaims) bool { return verifyAppId(v.AppId, c) }
+
+// verifyAppId returns true if the claims application ID matches the registered
+// application ID. An empty registered application ID never matches.
+func verifyAppId(appid string, c *AuthClaims) bool {
+	return appid != "" && c.AppId == appid
+}
+
+// verifierMap stores the association between identity providers (IDPs) and
+// their corresponding identity verifiers.
+type verifierMap map[string]IdentityVerifier
+
+// newVerifierMap creates a verifierMap from the provided identity verifiers.
+// It returns an error if a verifier is nil or if an IDP is registered twice.
+//
+// Parameters:
+//
+//	vs ([]IdentityVerifier): The identity verifiers to register.
+//
+// Returns:
+//
+//	verifierMap: The registered identity verifiers keyed by their IDP.
+//	error: An error if the verifiers could not be registered, otherwise nil.
+func newVerifierMap(vs []IdentityVerifier) (verifierMap, error) {
+	m := make(verifierMap, len(vs))
+	for _, v := range vs {
+		if v == nil {
+			return nil, errors.New("auth: nil identity verifier")
+		}
+		idp := v.IdProvider()
+		if _, ok := m[idp]; ok {
+			return nil, fmt.Errorf("auth: identity verifier %q registered twice", idp)
+		}
+		m[idp] = v
+	}
+	return m, nil
 }
 
-// VerifyServerMatch verifies whether the provided identity provider (IDP) and
-// application ID match the registered values.
-// It returns true if the provided IDP is registered and the provided application
-// ID matches the registered application ID for that IDP, otherwise it returns false.
+// VerifyServerMatch verifies whether the provided claims are issued for this
+// server by dispatching them to the identity verifier registered for the
+// claims identity provider (IDP).
 //
 // Parameters:
 //
-//	idp (string): The identity provider (IDP) string.
-//	appid (string): The application ID string.
+//	c (*AuthClaims): The verified auth token claims.
 //
 // Returns:
 //
-//	bool: True if the provided IDP is registered and the provided application
-//	ID matches the registered application ID for that IDP, otherwise false.
-func VerifyServerMatch(idp string, appid string) bool {
-	registeredAppId, ok := mapIdp[idp]
-	return ok && appid == registeredAppId
+//	bool: True if the claims IDP is registered and its identity verifier
+//	accepts the claims, otherwise false.
+func (m verifierMap) VerifyServerMatch(c *AuthClaims) bool {
+	v, ok := m[c.IdProvider]
+	return ok && v.Verify(c)
 }
@@ -0,0 +1,56 @@
+package auth
+
+import "testing"
+
+func TestNewVerifierMapRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   []IdentityVerifier
+	}{
+		{"Nil", []IdentityVerifier{AnonymousVerifier{}, nil}},
+		{"Duplicate", []IdentityVerifier{SteamVerifier{AppId: "1"}, SteamVerifier{AppId: "2"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if m, err := newVerifierMap(tt.vs); err == nil {
+				t.Fatalf("expected error, got %v", m)
+			}
+		})
+	}
+}
+
+func TestVerifyServerMatch(t *testing.T) {
+	m, err := newVerifierMap([]IdentityVerifier{
+		AnonymousVerifier{},
+		SteamVerifier{AppId: "480"},
+		EpicGamesVerifier{AppId: "epic"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		idp   string
+		appid string
+		valid bool
+	}{
+		{"Anonymous", ANONYMOUS_IDP_STRING, "", true},
+		{"AnonymousWithAppId", ANONYMOUS_IDP_STRING, "480", false},
+		{"Steam", STEAM_IDP_STRING, "480", true},
+		{"SteamOtherAppId", STEAM_IDP_STRING, "epic", false},
+		{"SteamEmptyAppId", STEAM_IDP_STRING, "", false},
+		{"EpicGames", EPIC_GAMES_IDP_STRING, "epic", true},
+		{"EpicGamesSteamAppId", EPIC_GAMES_IDP_STRING, "480", false},
+		{"Unregistered", PSN_IDP_STRING, "480", false},
+		{"Unknown", "unknown", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &AuthClaims{IdProvider: tt.idp, AppId: tt.appid}
+			if got := m.VerifyServerMatch(c); got != tt.valid {
+				t.Fatalf("expected valid=%v, got %v", tt.valid, got)
+			}
+		})
+	}
+}
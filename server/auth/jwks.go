@@ -0,0 +1,317 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// KeySet represents a set of token verification keys parsed from a JSON Web
+// Key Set (JWKS) document, keyed by their key ID (kid). A key without a key ID
+// is stored under the empty key ID and matches tokens without a kid header.
+type KeySet struct {
+	keys map[string]verificationKey
+}
+
+// verificationKey holds a parsed token verification key and the only signing
+// algorithm the key may be used with.
+type verificationKey struct {
+	alg string
+	key interface{}
+}
+
+// jsonWebKey represents the subset of RFC 7517 and RFC 7518 JWK parameters
+// needed to verify RSA, ECDSA, EdDSA and HMAC token signatures.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// ParseKeySet parses a JWKS document into a KeySet. Every key must either
+// declare its signing algorithm or have one implied by its curve, so that a
+// token can never select how its own key is interpreted.
+//
+// Parameters:
+//
+//	b ([]byte): The JWKS document in JSON format.
+//
+// Returns:
+//
+//	*KeySet: The parsed key set.
+//	error: An error if the document or any of its keys is invalid, otherwise nil.
+func ParseKeySet(b []byte) (*KeySet, error) {
+	var doc struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	ks := &KeySet{keys: make(map[string]verificationKey, len(doc.Keys))}
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if _, ok := ks.keys[k.Kid]; ok {
+			return nil, fmt.Errorf("jwks: duplicate kid %q", k.Kid)
+		}
+		vk, err := parseJsonWebKey(&k)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: kid %q: %w", k.Kid, err)
+		}
+		ks.keys[k.Kid] = vk
+	}
+	return ks, nil
+}
+
+// Lookup returns the verification key with the provided key ID, only if the
+// key is registered for the provided signing algorithm.
+//
+// Parameters:
+//
+//	kid (string): The key ID from the token header.
+//	alg (string): The signing algorithm from the token header.
+//
+// Returns:
+//
+//	interface{}: The verification key, or nil if no key matches.
+func (ks *KeySet) Lookup(kid, alg string) interface{} {
+	k, ok := ks.keys[kid]
+	if !ok || k.alg != alg {
+		return nil
+	}
+	return k.key
+}
+
+// Algorithms returns the signing algorithms used by the keys in the key set.
+func (ks *KeySet) Algorithms() []string {
+	seen := make(map[string]bool)
+	algs := make([]string, 0, len(ks.keys))
+	for _, k := range ks.keys {
+		if !seen[k.alg] {
+			seen[k.alg] = true
+			algs = append(algs, k.alg)
+		}
+	}
+	return algs
+}
+
+// parseJsonWebKey parses a single JWK into a verification key bound to its
+// signing algorithm.
+//
+// Parameters:
+//
+//	k (*jsonWebKey): The JWK to parse.
+//
+// Returns:
+//
+//	verificationKey: The parsed verification key.
+//	error: An error if the key type, curve or algorithm is unsupported, or if
+//	the key parameters are invalid, otherwise nil.
+func parseJsonWebKey(k *jsonWebKey) (verificationKey, error) {
+	switch k.Kty {
+	case "RSA":
+		switch k.Alg {
+		case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+		default:
+			return verificationKey{}, fmt.Errorf("unsupported RSA alg %q", k.Alg)
+		}
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return verificationKey{}, errors.New("invalid RSA exponent")
+		}
+		return verificationKey{k.Alg, &rsa.PublicKey{N: n, E: int(e.Int64())}}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		var alg string
+		switch k.Crv {
+		case "P-256":
+			curve, alg = elliptic.P256(), "ES256"
+		case "P-384":
+			curve, alg = elliptic.P384(), "ES384"
+		case "P-521":
+			curve, alg = elliptic.P521(), "ES512"
+		default:
+			return verificationKey{}, fmt.Errorf("unsupported EC curve %q", k.Crv)
+		}
+		if k.Alg != "" && k.Alg != alg {
+			return verificationKey{}, fmt.Errorf("alg %q does not match curve %q", k.Alg, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return verificationKey{}, errors.New("EC point is not on curve")
+		}
+		return verificationKey{alg, &ecdsa.PublicKey{Curve: curve, X: x, Y: y}}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return verificationKey{}, fmt.Errorf("unsupported OKP curve %q", k.Crv)
+		}
+		if k.Alg != "" && k.Alg != "EdDSA" {
+			return verificationKey{}, fmt.Errorf("alg %q does not match curve %q", k.Alg, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, errors.New("invalid Ed25519 public key size")
+		}
+		return verificationKey{"EdDSA", ed25519.PublicKey(x)}, nil
+
+	case "oct":
+		switch k.Alg {
+		case "HS256", "HS384", "HS512":
+		default:
+			return verificationKey{}, fmt.Errorf("unsupported oct alg %q", k.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if len(secret) == 0 {
+			return verificationKey{}, errors.New("empty oct key")
+		}
+		return verificationKey{k.Alg, secret}, nil
+
+	default:
+		return verificationKey{}, fmt.Errorf("unsupported kty %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty integer parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// keySetFile keeps the latest KeySet loaded from a JWKS file on disk. The file
+// is polled for modification so keys can be rotated without a restart.
+type keySetFile struct {
+	path    string
+	modTime time.Time
+	size    int64
+	current atomic.Pointer[KeySet]
+	watcher sync.WaitGroup // done once the reload goroutine has returned
+}
+
+// loadKeySetFile loads the JWKS file at the provided path. If interval is not
+// zero, a goroutine is spawned to reload the file whenever it changes, until the
+// provided context is done.
+//
+// Parameters:
+//
+//	ctx (context.Context): The context which stops the reload goroutine when done.
+//	path (string): The path of the JWKS file.
+//	interval (time.Duration): The interval between file modification checks.
+//
+// Returns:
+//
+//	*keySetFile: The loaded JWKS file.
+//	error: An error if the initial load fails, otherwise nil.
+func loadKeySetFile(ctx context.Context, path string, interval time.Duration) (*keySetFile, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &keySetFile{path: path, modTime: fi.ModTime(), size: fi.Size()}
+	if err := f.reload(); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		f.watcher.Add(1)
+		go f.watch(ctx, interval)
+	}
+	return f, nil
+}
+
+// KeySet returns the most recently loaded key set.
+func (f *keySetFile) KeySet() *KeySet {
+	return f.current.Load()
+}
+
+// watch reloads the JWKS file whenever its modification time or size changes.
+// A document which fails to parse is reported and the previous key set is kept.
+// It returns once the provided context is done, marking the watcher done.
+//
+// Parameters:
+//
+//	ctx (context.Context): The context which stops the reload when done.
+//	interval (time.Duration): The interval between file modification checks.
+func (f *keySetFile) watch(ctx context.Context, interval time.Duration) {
+	defer f.watcher.Done()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		fi, err := os.Stat(f.path)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+			continue
+		}
+		f.modTime = fi.ModTime()
+		f.size = fi.Size()
+		if err := f.reload(); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func (f *keySetFile) reload() error {
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+	ks, err := ParseKeySet(b)
+	if err != nil {
+		return err
+	}
+	f.current.Store(ks)
+	return nil
+}
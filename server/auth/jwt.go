@@ -1,7 +1,7 @@
 package auth
 
 import (
-	"encoding/hex"
+	"errors"
 
 	"github.com/golang-jwt/jwt"
 )
@@ -15,33 +15,34 @@ type AuthClaims struct {
 	AppId      string `json:"appid"`
 }
 
-var (
-	signingSecret []byte
-)
-
-func init() {
-	secretString := "5779c98432893f4af70e46dc99bbbe66523a7ebd19f75182228eea267fc8fa0e"
-	signingSecret, _ = hex.DecodeString(secretString)
-}
+var errUnknownKey = errors.New("auth: no key matches token kid and alg")
 
 // VerifyAuthClaims verifies and parses a JWT token string into an AuthClaims
-// struct. It takes a JWT token string as input and returns a pointer to an
-// AuthClaims struct if the JWT token is valid and successfully parsed, or nil
-// if there was an error.
+// struct. The verification key is selected from the provided key set by the
+// token's kid header, and is only used if it is registered for the token's alg
+// header. It returns a pointer to an AuthClaims struct if the JWT token is valid
+// and successfully parsed, or nil if there was an error.
 //
 // Parameters:
 //
 //	s (string): The JWT token string to verify and parse.
+//	ks (*KeySet): The key set used to verify the token signature.
 //
 // Returns:
 //
 //	*AuthClaims: A pointer to an AuthClaims struct if the JWT token is valid
 //	and successfully parsed, or nil if there was an error.
-func VerifyAuthClaims(s string) *AuthClaims {
+func VerifyAuthClaims(s string, ks *KeySet) *AuthClaims {
 	var kf jwt.Keyfunc = func(t *jwt.Token) (interface{}, error) {
-		return signingSecret, nil
+		kid, _ := t.Header["kid"].(string)
+		k := ks.Lookup(kid, t.Method.Alg())
+		if k == nil {
+			return nil, errUnknownKey
+		}
+		return k, nil
 	}
-	t, err := jwt.ParseWithClaims(s, &AuthClaims{}, kf)
+	p := &jwt.Parser{ValidMethods: ks.Algorithms()}
+	t, err := p.ParseWithClaims(s, &AuthClaims{}, kf)
 	if err != nil {
 		return nil
 	}
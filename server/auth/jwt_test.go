@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signToken(t *testing.T, m jwt.SigningMethod, kid string, key interface{}) string {
+	tk := jwt.NewWithClaims(m, &AuthClaims{Uid: 7, IdProvider: STEAM_IDP_STRING, AppId: "480"})
+	if kid != "" {
+		tk.Header["kid"] = kid
+	}
+	s, err := tk.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestVerifyAuthClaimsKeySet(t *testing.T) {
+	rk, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ek, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	secret := []byte("legacy-secret")
+
+	doc := fmt.Sprintf(`{"keys":[
+		{"kty":"RSA","kid":"rsa","alg":"RS256","n":%q,"e":%q},
+		{"kty":"EC","kid":"ec","crv":"P-256","x":%q,"y":%q},
+		{"kty":"OKP","kid":"ed","crv":"Ed25519","x":%q},
+		{"kty":"oct","alg":"HS256","k":%q}
+	]}`,
+		b64(rk.N.Bytes()), b64(big.NewInt(int64(rk.E)).Bytes()),
+		b64(ek.X.FillBytes(make([]byte, 32))), b64(ek.Y.FillBytes(make([]byte, 32))),
+		b64(edPub), b64(secret))
+	ks, err := ParseKeySet([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, _ := x509.MarshalPKIXPublicKey(&rk.PublicKey)
+	rsaPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"RS256", signToken(t, jwt.SigningMethodRS256, "rsa", rk), true},
+		{"ES256", signToken(t, jwt.SigningMethodES256, "ec", ek), true},
+		{"EdDSA", signToken(t, jwt.SigningMethodEdDSA, "ed", edKey), true},
+		{"HS256-NoKid", signToken(t, jwt.SigningMethodHS256, "", secret), true},
+		{"UnknownKid", signToken(t, jwt.SigningMethodRS256, "other", rk), false},
+		{"WrongAlgForKid", signToken(t, jwt.SigningMethodRS512, "rsa", rk), false},
+		{"HS256-RsaPublicKey", signToken(t, jwt.SigningMethodHS256, "rsa", rsaPem), false},
+		{"HS256-WrongSecret", signToken(t, jwt.SigningMethodHS256, "", []byte("other")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := VerifyAuthClaims(tt.token, ks)
+			if (c != nil) != tt.valid {
+				t.Fatalf("expected valid=%v, got claims %v", tt.valid, c)
+			}
+			if c != nil && c.Uid != 7 {
+				t.Fatalf("unexpected uid %d", c.Uid)
+			}
+		})
+	}
+}
+
+func TestParseKeySetRejectsAmbiguousKeys(t *testing.T) {
+	docs := []string{
+		`{"keys":[{"kty":"RSA","kid":"a","n":"AQAB","e":"AQAB"}]}`,
+		`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`,
+		`{"keys":[{"kty":"OKP","crv":"Ed25519","alg":"ES256","x":"AA"}]}`,
+		`{"keys":[{"kty":"oct","alg":"HS256","k":"YQ"},{"kty":"oct","alg":"HS256","k":"Yg"}]}`,
+	}
+	for _, d := range docs {
+		if _, err := ParseKeySet([]byte(d)); err == nil {
+			t.Errorf("expected error for %s", d)
+		}
+	}
+}
+
+func TestKeySetFileStopsReloading(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	write := func(kid string) {
+		doc := fmt.Sprintf(`{"keys":[{"kty":"oct","kid":%q,"alg":"HS256","k":"c2VjcmV0"}]}`, kid)
+		if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f, err := loadKeySetFile(ctx, path, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	write("bb")
+	deadline := time.Now().Add(time.Second)
+	for f.KeySet().Lookup("bb", "HS256") == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("changed JWKS file was not reloaded")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	f.watcher.Wait()
+	write("ccc")
+	if f.KeySet().Lookup("ccc", "HS256") != nil {
+		t.Fatal("JWKS file was reloaded after the context was done")
+	}
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"crypto/ecdh"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"google.golang.org/protobuf/proto"
+)
+
+// Auth Request TLS Frame:
+// Format    : [Version] [Length] [Protobuf Data]
+// Version 1 : [1] [Length] [AuthRequest]
+//
+// - Size (in bits):
+//   - Version: 8
+//   - Length: 16
+//   - Protobuf Data: Variable, exactly Length bytes
+//
+// The client sends exactly one frame per connection. The AuthRequest carries the
+// JWT along with the client version, platform and requested protocol version.
+// A protocol version 2 or 3 request also carries the client X25519 public key, so
+// the session key is agreed on instead of being sent back. Protocol version 3 also
+// prefixes the packet nonces with the sender role, see the game packet format.
+
+const (
+	frameVersion      uint8 = 1
+	frameVersionLen   int   = 8 / 8
+	frameLengthLen    int   = 16 / 8
+	frameHeaderLen    int   = frameVersionLen + frameLengthLen
+	frameLengthBegPos int   = frameVersionLen
+)
+
+var (
+	errFrameVersion = errors.New("auth: unsupported request frame version")
+	errFrameLength  = errors.New("auth: invalid request frame length")
+)
+
+// readLoginRequest reads exactly one auth request frame from the provided reader
+// and decodes its AuthRequest message. The read deadline of the underlying
+// connection bounds the whole frame, not only its first segment.
+//
+// Parameters:
+//   - r: The reader from which the frame is read.
+//   - maxLen: The maximum accepted length of the protobuf data.
+//
+// Returns:
+//   - *protobuf.AuthRequest: The decoded auth request.
+//   - error: An error if the frame could not be read or decoded, otherwise nil.
+func readLoginRequest(r io.Reader, maxLen int) (*protobuf.AuthRequest, error) {
+	var h [frameHeaderLen]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
+		return nil, err
+	}
+
+	if h[0] != frameVersion {
+		return nil, errFrameVersion
+	}
+
+	n := int(binary.BigEndian.Uint16(h[frameLengthBegPos:]))
+	if n == 0 || n > maxLen {
+		return nil, errFrameLength
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+
+	req := &protobuf.AuthRequest{}
+	if err := proto.Unmarshal(b, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// newSessionFunc returns the NewSession function for the protocol version
+// requested by the client.
+//
+// Parameters:
+//   - req: The auth request sent by the client.
+//
+// Returns:
+//   - server.NewSession: The function for creating the session.
+//   - bool: True if the requested protocol version is supported, otherwise false.
+func newSessionFunc(req *protobuf.AuthRequest) (server.NewSession, bool) {
+	switch req.ProtocolVersion {
+	case 1:
+		return server.NewSessionV1, true
+	case 2, 3:
+		pub, err := ecdh.X25519().NewPublicKey(req.X25519PublicKey)
+		if err != nil {
+			return nil, false
+		}
+		if req.ProtocolVersion == 2 {
+			return server.NewSessionV2(pub), true
+		}
+		return server.NewSessionV3(pub), true
+	default:
+		return nil, false
+	}
+}
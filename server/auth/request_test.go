@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+func newFrame(t *testing.T, version uint8, req *protobuf.AuthRequest) []byte {
+	p, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := []byte{version}
+	b = binary.BigEndian.AppendUint16(b, uint16(len(p)))
+	return append(b, p...)
+}
+
+func TestReadLoginRequestSegmented(t *testing.T) {
+	want := &protobuf.AuthRequest{
+		Token:           "eyJhbGciOiJIUzI1NiJ9.e30.sig",
+		ClientVersion:   "1.4.2",
+		Platform:        protobuf.Platform_PLATFORM_WINDOWS,
+		ProtocolVersion: 2,
+	}
+	f := newFrame(t, frameVersion, want)
+
+	// Trailing bytes must be left unread, only one frame is consumed.
+	r := iotest.OneByteReader(bytes.NewReader(append(f, 0xff)))
+	got, err := readLoginRequest(r, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestReadLoginRequestInvalid(t *testing.T) {
+	req := &protobuf.AuthRequest{Token: "token", ProtocolVersion: 1}
+	valid := newFrame(t, frameVersion, req)
+
+	tests := map[string][]byte{
+		"Version":   newFrame(t, frameVersion+1, req),
+		"Truncated": valid[:len(valid)-1],
+		"Empty":     {frameVersion, 0, 0},
+		"TooLong":   {frameVersion, 0xff, 0xff},
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := readLoginRequest(bytes.NewReader(b), 1024); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
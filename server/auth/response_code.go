@@ -4,19 +4,26 @@ package auth
 // Format    : [Version] [Payload]
 // Payload   : [Response Code] [Protobuf Data]
 //
+// Login Success and Login Resumed share the same protobuf data, a resumed session
+// keeps its SIDX and game state.
+//
 // - Size (in bits):
 //   - Response Code: 8
 //   - SIDX: 32
-//   - AES Key: 256
+//   - AES Key: 256 (version 1 session only)
+//   - X25519 Public Key: 256 (version 2 and 3 sessions)
+//   - Reconnect Token: 256
 
 type response = uint8
 
 const (
-	responseUnknown       response = 0
-	responseInternalError response = 1
-	responseLoginTimeout  response = 2
-	responseInvalidToken  response = 3
-	responseInvalidServer response = 4
-	responseLoginConflict response = 5
-	responseLoginSuccess  response = 6
+	responseUnknown        response = 0
+	responseInternalError  response = 1
+	responseLoginTimeout   response = 2
+	responseInvalidToken   response = 3
+	responseInvalidServer  response = 4
+	responseLoginConflict  response = 5
+	responseLoginSuccess   response = 6
+	responseInvalidRequest response = 7
+	responseLoginResumed   response = 8
 )
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func checkEnvelope(t *testing.T, envelope proto.Message, messages map[uint8]proto.Message) {
+	fields := envelope.ProtoReflect().Descriptor().Fields()
+	if fields.Len() != len(messages) {
+		t.Errorf("%s: %d fields, want %d", envelope.ProtoReflect().Descriptor().Name(), fields.Len(), len(messages))
+	}
+	for code, m := range messages {
+		fd := fields.ByNumber(protoreflect.FieldNumber(code))
+		want := m.ProtoReflect().Descriptor().FullName()
+		if fd == nil || fd.Message().FullName() != want {
+			t.Errorf("code %d: envelope field does not hold %s", code, want)
+		}
+	}
+}
+
+func TestEnvelopeFieldNumbers(t *testing.T) {
+	checkEnvelope(t, &protobuf.GameRequest{}, map[uint8]proto.Message{
+		RequestCode_SyncPos:              &request.SyncPos{},
+		RequestCode_Logout:               &request.Logout{},
+		RequestCode_CreateLobby:          &request.CreateLobby{},
+		RequestCode_InviteLobby:          &request.InviteLobby{},
+		RequestCode_LeaveLobby:           &request.LeaveLobby{},
+		RequestCode_AcceptLobbyInvites:   &request.AcceptLobbyInvites{},
+		RequestCode_Reliable:             &request.Reliable{},
+		RequestCode_RequestJoinLobby:     &request.RequestJoinLobby{},
+		RequestCode_RespondJoinLobby:     &request.RespondJoinLobby{},
+		RequestCode_DismissLobby:         &request.DismissLobby{},
+		RequestCode_SetLobbyMode:         &request.SetLobbyMode{},
+		RequestCode_SetLobbyHost:         &request.SetLobbyHost{},
+		RequestCode_SetLobbyReady:        &request.SetLobbyReady{},
+		RequestCode_KickLobbyPlayer:      &request.KickLobbyPlayer{},
+		RequestCode_ListLobbyInvitations: &request.ListLobbyInvitations{},
+		RequestCode_SetLobbyPrivacy:      &request.SetLobbyPrivacy{},
+		RequestCode_ListJoinRequests:     &request.ListJoinRequests{},
+		RequestCode_SearchLobbies:        &request.SearchLobbies{},
+		RequestCode_LobbyChat:            &request.LobbyChat{},
+	})
+	checkEnvelope(t, &protobuf.GameResponse{}, map[uint8]proto.Message{
+		ResponseCode_SyncPos:               &response.SyncPos{},
+		ResponseCode_Disconnected:          &response.Disconnected{},
+		ResponseCode_Connected:             &response.Connected{},
+		ResponseCode_Reconnecting:          &response.Reconnecting{},
+		ResponseCode_CreateLobby:           &response.CreateLobby{},
+		ResponseCode_JoinLobby:             &response.JoinLobby{},
+		ResponseCode_Rekey:                 &response.Rekey{},
+		ResponseCode_Error:                 &response.Error{},
+		ResponseCode_Snapshot:              &response.Snapshot{},
+		ResponseCode_Reliable:              &response.Reliable{},
+		ResponseCode_LobbyUpdate:           &response.LobbyUpdate{},
+		ResponseCode_LobbyInvitation:       &response.LobbyInvitation{},
+		ResponseCode_LobbyJoinRequest:      &response.LobbyJoinRequest{},
+		ResponseCode_LobbyInvitationUpdate: &response.LobbyInvitationUpdate{},
+		ResponseCode_LobbyInvitations:      &response.LobbyInvitations{},
+		ResponseCode_LobbyJoinRequests:     &response.LobbyJoinRequests{},
+		ResponseCode_LobbySearch:           &response.LobbySearch{},
+		ResponseCode_LobbyChat:             &response.LobbyChat{},
+		ResponseCode_LobbyChatHistory:      &response.LobbyChatHistory{},
+	})
+}
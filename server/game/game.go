@@ -1,7 +1,9 @@
 package game
 
 import (
+	"context"
 	"net"
+	"time"
 
 	"github.com/pemmel/gameserver/server"
 )
@@ -11,6 +13,38 @@ type Config struct {
 	QueueCapacity   int
 	QueueBufferSize int
 	NbWorkers       int
+	RekeyOverlap    time.Duration // how long the previous session key remains accepted after a rekey
+
+	SendQueueCapacity int           // capacity of the outbound packet queue
+	SendBatchSize     int           // maximum number of packets written at once
+	SendBlockTimeout  time.Duration // how long a full send queue is waited on before dropping
+
+	SyncPosRate int     // snapshots of the match player states sent per second
+	MaxSpeed    float32 // maximum player speed in units per second
+	MaxTeleport float32 // maximum distance between two position updates, negative to disable
+
+	TickRate      int           // simulation ticks per second of every live match
+	TickWorkers   int           // goroutines running the match ticks, NbWorkers if zero
+	MatchDuration time.Duration // maximum duration of a match, 0 for unlimited
+
+	MaxRewind   time.Duration // maximum age of the match state used to validate hits
+	InterpDelay time.Duration // client snapshot interpolation delay, 2 snapshot intervals if zero
+
+	MaxDatagramSize    int // maximum size of a sent packet, larger messages are fragmented
+	MaxMessageSize     int // maximum size of a fragmented message
+	MaxReassemblyBytes int // maximum size of the partial messages held per session
+
+	InvitationTTL  time.Duration // how long a lobby invitation remains pending
+	MaxInvitations int           // maximum pending lobby invitations issued per player
+	Friendships    Friendships   // friendships of the users, nobody is a friend and friends-of-members lobbies reject join requests if nil
+
+	ChatInterval  time.Duration // average interval between the lobby chat messages of a player
+	ChatBurst     int           // lobby chat messages a player may send at once
+	MaxChatLength int           // maximum length of a lobby chat message in characters
+	ChatHistory   int           // lobby chat messages delivered to the players who join late
+	ChatFilter    ChatFilter    // moderation of the lobby chat messages, none if nil
+
+	HostMigration HostMigrationPolicy // how the new lobby host is chosen when the host leaves
 }
 
 // RunGameServer starts the game server with the provided configuration.
@@ -19,6 +53,7 @@ type Config struct {
 // to process the packets concurrently.
 //
 // Parameters:
+//   - ctx (context.Context): The context which stops the match tick workers when done.
 //   - c (Config): The configuration for the game server.
 //
 // Returns:
@@ -29,11 +64,69 @@ type Config struct {
 // incoming packets, and creates worker goroutines to process the packets concurrently. If any errors
 // occur during server setup, an error is returned; otherwise, nil is returned to indicate successful
 // server initialization.
-func RunGameServer(c Config) error {
+func RunGameServer(ctx context.Context, c Config) error {
+	server.SharedSession().OnExpire(release)
+	server.SharedSession().OnResume(resume)
+
 	server, err := net.ListenUDP("udp4", c.Address)
 	if err != nil {
 		return err
 	}
+	sender = NewSender(server, c.SendQueueCapacity, c.SendBatchSize, c.SendBlockTimeout)
+	if c.RekeyOverlap > 0 {
+		rekeyOverlap = c.RekeyOverlap
+	}
+	if c.SyncPosRate > 0 {
+		syncPosInterval = time.Second / time.Duration(c.SyncPosRate)
+	}
+	if c.MaxSpeed > 0 {
+		maxSpeed = c.MaxSpeed
+	}
+	if c.MaxTeleport != 0 {
+		maxTeleport = c.MaxTeleport
+	}
+	if c.TickRate > 0 {
+		tickInterval = time.Second / time.Duration(c.TickRate)
+	}
+	matchDuration = c.MatchDuration
+	if c.MaxRewind > 0 {
+		maxRewind = c.MaxRewind
+	}
+	interpDelay = c.InterpDelay
+	if c.MaxDatagramSize >= minDatagramSize {
+		maxDatagramSize = c.MaxDatagramSize
+	}
+	if c.MaxMessageSize > 0 {
+		maxMessageSize = c.MaxMessageSize
+	}
+	if c.MaxReassemblyBytes > 0 {
+		maxReassemblyBytes = c.MaxReassemblyBytes
+	}
+	if c.InvitationTTL > 0 {
+		invitationTTL = c.InvitationTTL
+	}
+	if c.MaxInvitations > 0 {
+		maxInvitations = c.MaxInvitations
+	}
+	friendships = c.Friendships
+	if c.ChatInterval > 0 {
+		chatInterval = c.ChatInterval
+	}
+	if c.ChatBurst > 0 {
+		chatBurst = c.ChatBurst
+	}
+	if c.MaxChatLength > 0 {
+		maxChatLength = c.MaxChatLength
+	}
+	if c.ChatHistory > 0 {
+		chatHistory = c.ChatHistory
+	}
+	chatFilter = c.ChatFilter
+	hostMigration = c.HostMigration
+	if c.TickWorkers <= 0 {
+		c.TickWorkers = c.NbWorkers
+	}
+	scheduler = newTickScheduler(ctx, c.TickWorkers, tickInterval)
 
 	chp := make(chan packet, c.QueueCapacity)
 	go listener(server, chp, c.QueueBufferSize)
@@ -42,6 +135,9 @@ func RunGameServer(c Config) error {
 	}
 
 	go matchmaking()
+	go retransmitter()
+	go reassemblySweeper()
+	go invitationExpirer()
 
 	return nil
 }
@@ -61,7 +157,7 @@ func listener(server *net.UDPConn, chp chan packet, bufferSize int) {
 	b := make([]byte, bufferSize)
 	for {
 		n, addr, err := server.ReadFromUDP(b)
-		if err != nil || packetMeaningful(b[:n]) {
+		if err != nil || !packetMeaningful(b[:n]) {
 			continue
 		}
 
@@ -1,9 +1,14 @@
 package game
 
 import (
+	"errors"
+	"fmt"
 	"net"
 
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/protobuf"
 	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -12,20 +17,209 @@ type handleT struct {
 	addr        *net.UDPAddr
 	requestCode uint8
 	payload     []byte
+	reliable    bool  // delivered on a reliable channel
+	channel     uint8 // reliable channel, only if reliable is set
+	records     bool  // payload holds the records of a record layout packet
 }
 
+// Request represents a verified client request passed to its registered handler.
+type Request struct {
+	Session *server.Session
+	Addr    *net.UDPAddr
+	Code    uint8
+	State   int // game state of the session when the request was dispatched
+}
+
+// Reply sends a response to the client of the request session.
+//
+// Parameters:
+//   - code: The response code.
+//   - m: The response message, or nil for a response without payload.
+//
+// Returns:
+//   - bool: True if the response has been queued, otherwise false.
+func (r *Request) Reply(code uint8, m proto.Message) bool {
+	var p []byte
+	if m != nil {
+		var err error
+		p, err = proto.Marshal(m)
+		if err != nil {
+			return false
+		}
+	}
+	return send(r.Session, code, p)
+}
+
+// StateMask represents a set of server.GameState_* values.
+type StateMask uint32
+
+// AnyState allows a request in every game state.
+const AnyState StateMask = ^StateMask(0)
+
+// States returns the StateMask of the provided game states.
+func States(states ...int) StateMask {
+	var m StateMask
+	for _, s := range states {
+		m |= 1 << s
+	}
+	return m
+}
+
+// Has reports whether the provided game state is in the mask.
+func (m StateMask) Has(state int) bool {
+	return state >= 0 && state < 32 && m&(1<<state) != 0
+}
+
+var (
+	// ErrUnknownRequest is returned for a request code without registered handler.
+	ErrUnknownRequest = errors.New("game: unknown request code")
+
+	// ErrInvalidRequest is returned for a request payload which is not a valid
+	// message of the registered type. Handlers may wrap it to reject a request.
+	ErrInvalidRequest = errors.New("game: invalid request")
+
+	// ErrInvalidState is returned for a request not allowed in the game state of
+	// the session. Handlers may wrap it to reject a request.
+	ErrInvalidState = errors.New("game: invalid game state")
+)
+
+var (
+	requestUnknownCounter *common.Counter
+	requestInvalidCounter *common.Counter
+	requestStateCounter   *common.Counter
+	requestErrorCounter   *common.Counter
+)
+
+func init() {
+	requestUnknownCounter = common.RegisterNewCounter("Request Unknown Code")
+	requestInvalidCounter = common.RegisterNewCounter("Request Invalid")
+	requestStateCounter = common.RegisterNewCounter("Request Invalid State")
+	requestErrorCounter = common.RegisterNewCounter("Request Error")
+}
+
+// route holds the handler registered for a request code.
+type route struct {
+	states StateMask
+	handle func(r *Request, payload []byte) error
+}
+
+// routes holds the registered routes indexed by request code. It is only written
+// by Register, before the game server starts.
+var routes [256]*route
+
+// Register registers the handler of a request code. The request payload is
+// unmarshalled into a new message of type T before the handler is called, and
+// the request is rejected unless the session game state is in states. A handler
+// error is replied to the client with ResponseCode_Error.
+// Register must be called before RunGameServer, and panics if the request code
+// is already registered.
+//
+// Parameters:
+//   - code: The request code.
+//   - states: The game states in which the request is allowed.
+//   - f: The handler called with the request and its unmarshalled message.
+func Register[T proto.Message](code uint8, states StateMask, f func(r *Request, m T) error) {
+	if routes[code] != nil {
+		panic(fmt.Sprintf("game: request code %d already registered", code))
+	}
+
+	var zero T
+	mt := zero.ProtoReflect().Type()
+	routes[code] = &route{
+		states: states,
+		handle: func(r *Request, payload []byte) error {
+			m := mt.New().Interface().(T)
+			if err := proto.Unmarshal(payload, m); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+			}
+			return f(r, m)
+		},
+	}
+}
+
+// handle dispatches a verified request to its registered handler and replies
+// to the client with ResponseCode_Error if the request is rejected. The records
+// of a record layout packet are dispatched one by one.
 func handle(h *handleT) {
-	switch h.requestCode {
-	case RequestCode_SyncPos:
-		proto.Unmarshal(h.payload, nil)
+	if h.records {
+		handleRecords(h)
+		return
+	}
+
+	err := dispatch(h)
+	if err == nil {
+		return
+	}
+
+	code := errorCode(err)
+	switch code {
+	case protobuf.ErrorCode_ERROR_CODE_UNKNOWN_REQUEST:
+		requestUnknownCounter.Increment()
+	case protobuf.ErrorCode_ERROR_CODE_INVALID_REQUEST:
+		requestInvalidCounter.Increment()
+	case protobuf.ErrorCode_ERROR_CODE_INVALID_STATE:
+		requestStateCounter.Increment()
+	default:
+		requestErrorCounter.Increment()
+	}
 
-	case RequestCode_Logout:
-		proto.Unmarshal(h.payload, nil)
+	p, err := proto.Marshal(&response.Error{
+		RequestCode: uint32(h.requestCode),
+		Code:        code,
+	})
+	if err != nil {
+		return
+	}
+	send(h.session, ResponseCode_Error, p)
+}
+
+// dispatch calls the handler registered for the request code, only if the
+// session game state is allowed and, when the request arrived in a reliable
+// frame, on the reliable channel of its code.
+//
+// Returns:
+//   - error: The reason the request has been rejected, otherwise nil.
+func dispatch(h *handleT) error {
+	rt := routes[h.requestCode]
+	if rt == nil {
+		return ErrUnknownRequest
+	}
 
-	case RequestCode_CreateLobby:
-		proto.Unmarshal(h.payload, nil)
+	// A reliable request must arrive on the channel of its code, and reliable
+	// frames are never nested.
+	if c := requestChannels[h.requestCode]; c != 0 && h.reliable && h.channel != c-1 {
+		return ErrInvalidRequest
+	}
+	if h.reliable && h.requestCode == RequestCode_Reliable {
+		return ErrInvalidRequest
+	}
+
+	h.session.Mutex.Lock()
+	state := h.session.GameState
+	h.session.Mutex.Unlock()
+
+	if !rt.states.Has(state) {
+		return ErrInvalidState
+	}
+
+	return rt.handle(&Request{
+		Session: h.session,
+		Addr:    h.addr,
+		Code:    h.requestCode,
+		State:   state,
+	}, h.payload)
+}
 
+// errorCode returns the ErrorResponse code of a request error.
+func errorCode(err error) protobuf.ErrorCode {
+	switch {
+	case errors.Is(err, ErrUnknownRequest):
+		return protobuf.ErrorCode_ERROR_CODE_UNKNOWN_REQUEST
+	case errors.Is(err, ErrInvalidRequest):
+		return protobuf.ErrorCode_ERROR_CODE_INVALID_REQUEST
+	case errors.Is(err, ErrInvalidState):
+		return protobuf.ErrorCode_ERROR_CODE_INVALID_STATE
 	default:
-		break
+		return protobuf.ErrorCode_ERROR_CODE_INTERNAL
 	}
 }
@@ -0,0 +1,59 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDispatchRegistry(t *testing.T) {
+	const code uint8 = 250
+	defer func() { routes[code] = nil }()
+
+	errRejected := errors.New("rejected")
+	var got *protobuf.RekeyResponse
+	Register(code, States(server.GameState_Lobby), func(r *Request, m *protobuf.RekeyResponse) error {
+		if m.Epoch == 0 {
+			return errRejected
+		}
+		got = m
+		return nil
+	})
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	p, _ := proto.Marshal(&protobuf.RekeyResponse{Epoch: 3})
+	h := &handleT{session: s, requestCode: code, payload: p}
+
+	if err := dispatch(h); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("expected invalid state, got %v", err)
+	}
+
+	s.GameState = server.GameState_Lobby
+	if err := dispatch(h); err != nil || got.GetEpoch() != 3 {
+		t.Fatalf("handler not called with the request message: %v", err)
+	}
+
+	tests := map[*handleT]protobuf.ErrorCode{
+		{session: s, requestCode: code, payload: []byte{0xff}}: protobuf.ErrorCode_ERROR_CODE_INVALID_REQUEST,
+		{session: s, requestCode: code}:                        protobuf.ErrorCode_ERROR_CODE_INTERNAL,
+		{session: s, requestCode: code - 1}:                    protobuf.ErrorCode_ERROR_CODE_UNKNOWN_REQUEST,
+	}
+	for h, want := range tests {
+		if got := errorCode(dispatch(h)); got != want {
+			t.Errorf("request code %d: got %v, want %v", h.requestCode, got, want)
+		}
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	Register(RequestCode_Logout, AnyState, func(*Request, *protobuf.ErrorResponse) error { return nil })
+}
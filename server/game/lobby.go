@@ -1,21 +1,44 @@
 package game
 
 import (
+	"fmt"
+	"slices"
+	"sync"
 	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
 )
 
 type LobbyRoom struct {
 	Mode     uint8
 	Idx      uint32
-	HostSidx uint32
+	HostSidx server.Handle
 	Guests   []LobbyGuest
+	Privacy  protobuf.LobbyPrivacy
+
+	HostJoined time.Time // when the host joined the lobby
+
+	// JoinRequests holds the players waiting for the host to respond to their
+	// join request, in request order.
+	JoinRequests []server.Handle
+
+	chat []*response.LobbyChat // latest text messages, oldest first
 }
 
 func (r *LobbyRoom) PlayerCount() int {
 	return 1 + len(r.Guests)
 }
 
-func (r *LobbyRoom) PlayerSidx(b []uint32) []uint32 {
+// guestIndex returns the index of the provided player in Guests, or -1 if the
+// player is not a guest of the lobby.
+func (r *LobbyRoom) guestIndex(sidx server.Handle) int {
+	return slices.IndexFunc(r.Guests, func(g LobbyGuest) bool { return g.Sidx == sidx })
+}
+
+func (r *LobbyRoom) PlayerSidx(b []server.Handle) []server.Handle {
 	b = append(b, r.HostSidx)
 	for _, g := range r.Guests {
 		b = append(b, g.Sidx)
@@ -25,78 +48,624 @@ func (r *LobbyRoom) PlayerSidx(b []uint32) []uint32 {
 
 type LobbyGuest struct {
 	Ready         bool
-	Sidx          uint32
-	InvitedBySidx uint32
+	Sidx          server.Handle
+	InvitedBySidx server.Handle
+	Joined        time.Time // when the guest joined the lobby
 }
 
 type LobbyInvitation struct {
-	InvitorSidx uint32
-	InviteeSidx uint32
+	InvitorSidx server.Handle
+	InviteeSidx server.Handle
+	LobbyIdx    uint32    // lobby of the invitor when the invitation was issued
+	Expires     time.Time // the invitation is no longer pending from then on
 }
 
+var (
+	// ErrNotLobbyMember is returned when a player is required to be inside of a lobby.
+	ErrNotLobbyMember = fmt.Errorf("%w: player is not inside of a lobby", ErrInvalidState)
+
+	// ErrNotLobbyHost is returned for a lobby request reserved to the lobby host.
+	ErrNotLobbyHost = fmt.Errorf("%w: player is not the lobby host", ErrInvalidState)
+
+	// ErrLobbyFull is returned when a player would exceed the lobby capacity.
+	ErrLobbyFull = fmt.Errorf("%w: lobby is full", ErrInvalidState)
+
+	// ErrPlayerUnavailable is returned when a player can not join a lobby, because
+	// it is offline, already inside of a lobby, queueing or playing a match.
+	ErrPlayerUnavailable = fmt.Errorf("%w: player is unavailable", ErrInvalidState)
+
+	// ErrNoInvitation is returned when responding to an invitation which has not
+	// been issued or is no longer pending.
+	ErrNoInvitation = fmt.Errorf("%w: no pending invitation", ErrInvalidRequest)
+
+	// ErrNoJoinRequest is returned when responding to a join request which has not
+	// been issued or is no longer pending.
+	ErrNoJoinRequest = fmt.Errorf("%w: no pending join request", ErrInvalidRequest)
+)
+
 // rules:
 // sidx: player which will be the host and not yet belong to a lobby
 // mode: mode of the lobby
-func lobbyCreate(sidx uint32, mode uint8) {}
+func lobbyCreate(sidx server.Handle, mode uint8) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r := openLobby(sidx, mode)
+	if err := enterLobby(sidx, r); err != nil {
+		closeLobby(r)
+		return err
+	}
+	lobbyNotify(sidx, ResponseCode_CreateLobby, &response.CreateLobby{
+		LobbyIdx: r.Idx,
+		Mode:     uint32(r.Mode),
+		HostSidx: uint32(r.HostSidx),
+	})
+	return nil
+}
 
 // rules:
 // sidx: player which currently inside of a lobby and requesting to leave
-func lobbyLeave(sidx uint32) {}
+// a guest chosen by hostMigration becomes the host when the host leaves, and the
+// lobby is dismissed if it becomes empty
+func lobbyLeave(sidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r := lobbyOf(sidx)
+	if r == nil {
+		return ErrNotLobbyMember
+	}
+	if r.HostSidx == sidx {
+		if len(r.Guests) == 0 {
+			disbandLobby(r, sidx)
+		} else {
+			migrateHost(r)
+		}
+		return nil
+	}
+
+	removeGuest(r, sidx)
+	lobbyNotify(sidx, ResponseCode_LobbyUpdate, &response.LobbyUpdate{
+		Event:       protobuf.LobbyEvent_LOBBY_EVENT_LEFT,
+		SubjectSidx: uint32(sidx),
+		LobbyIdx:    r.Idx,
+	})
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_LEFT, sidx)
+	return nil
+}
 
 // rules:
 // sidx: a player who request to join
 // lobbySidx: a player which belong to a lobby and want to be joined
-func lobbyRequestJoin(sidx, lobbySidx uint32) {}
+// the request is accepted right away on an open lobby, and is pending until the
+// lobby host responds to it on a friends-of-members lobby if the player is a
+// friend of a member
+func lobbyRequestJoin(sidx, lobbySidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	if _, ok := lobbyMembers[sidx]; ok {
+		return ErrPlayerUnavailable
+	}
+	r := lobbyOf(lobbySidx)
+	if r == nil {
+		return ErrNotLobbyMember
+	}
+	if r.PlayerCount() >= mmPlayerPerTeam {
+		return ErrLobbyFull
+	}
+
+	switch r.Privacy {
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_OPEN:
+		return joinLobby(r, sidx, r.HostSidx)
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_HOST_APPROVAL:
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_FRIENDS:
+		if !friendOfMember(r, sidx) {
+			return ErrLobbyPrivate
+		}
+	default:
+		return ErrLobbyPrivate
+	}
+	if slices.Contains(r.JoinRequests, sidx) {
+		return nil
+	}
+
+	r.JoinRequests = append(r.JoinRequests, sidx)
+	lobbyNotify(r.HostSidx, ResponseCode_LobbyJoinRequest, &response.LobbyJoinRequest{
+		RequesterSidx: uint32(sidx),
+		LobbyIdx:      r.Idx,
+	})
+	return nil
+}
 
 // rules:
 // sidx: owner of a lobby
 // otherSidx: a player which previously request to join
 // accept: respond status (accept or decline the request)
-func lobbyRespondJoinRequest(sidx, otherSidx uint32, accept bool) {}
+func lobbyRespondJoinRequest(sidx, otherSidx server.Handle, accept bool) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	i := slices.Index(r.JoinRequests, otherSidx)
+	if i < 0 {
+		return ErrNoJoinRequest
+	}
+	r.JoinRequests = slices.Delete(r.JoinRequests, i, i+1)
+
+	if !accept {
+		lobbyNotify(otherSidx, ResponseCode_LobbyUpdate, &response.LobbyUpdate{
+			Event:       protobuf.LobbyEvent_LOBBY_EVENT_DECLINED,
+			SubjectSidx: uint32(sidx),
+			LobbyIdx:    r.Idx,
+		})
+		return nil
+	}
+	return joinLobby(r, otherSidx, sidx)
+}
 
 // rules:
 // sidx: player who owns a lobby which will be dismissed
-func lobbyDismiss(sidx uint32) {}
+func lobbyDismiss(sidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	disbandLobby(r, sidx)
+	return nil
+}
 
 // rules:
 // sidx: owner of a lobby
 // mode: mode to set
-func lobbySetMode(sidx uint32, mode uint8) {}
+func lobbySetMode(sidx server.Handle, mode uint8) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	if r.Mode == mode {
+		return nil
+	}
+	r.Mode = mode
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_MODE, sidx)
+	return nil
+}
 
 // rules:
 // sidx: owner of a lobby
 // targetSidx: player inside of owner lobby which will be the host
-func lobbySetHost(sidx, targetSidx uint32) {}
+// the previous host takes the place of the new host among the guests
+func lobbySetHost(sidx, targetSidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	if targetSidx == sidx {
+		return nil
+	}
+	i := r.guestIndex(targetSidx)
+	if i < 0 {
+		return ErrNotLobbyMember
+	}
+	g := r.Guests[i]
+	r.Guests[i] = LobbyGuest{Sidx: sidx, InvitedBySidx: targetSidx, Joined: r.HostJoined}
+	r.HostSidx = targetSidx
+	r.HostJoined = g.Joined
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_HOST, targetSidx)
+	return nil
+}
 
 // rules:
 // sidx: player inside of lobby
 // ready: ready state of player lobby
-func lobbySetReady(sidx uint32, ready bool) {}
+// the host is always ready
+func lobbySetReady(sidx server.Handle, ready bool) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r := lobbyOf(sidx)
+	if r == nil {
+		return ErrNotLobbyMember
+	}
+	if r.HostSidx == sidx {
+		return fmt.Errorf("%w: lobby host is always ready", ErrInvalidRequest)
+	}
+	g := &r.Guests[r.guestIndex(sidx)]
+	if g.Ready == ready {
+		return nil
+	}
+	g.Ready = ready
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_READY, sidx)
+	return nil
+}
 
 // rules:
 // sidx: owner of a lobby
 // start (start: true): host
 // cancel (start: false): all
-func lobbySetMatchmaking(sidx uint32, start bool) {}
+// the pending invitations of the lobby are cancelled once it enters the queue
+func lobbySetMatchmaking(sidx server.Handle, start bool) {
+	if !start {
+		return
+	}
+
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	if r, err := hostedLobby(sidx); err == nil {
+		cancelInvitations(func(inv LobbyInvitation) bool {
+			return inv.LobbyIdx == r.Idx
+		}, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+	}
+}
 
 // rules:
 // sidx: a player who issues an invite, must be inside of a lobby
 // targetSidx: a player who will receive the invitation
-func lobbyInvitePlayer(sidx, targetSidx uint32) {}
+// the target must be available to join the lobby, the lobby may not be closed,
+// and the invitor may not have more than maxInvitations pending invitations
+func lobbyInvitePlayer(sidx, targetSidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r := lobbyOf(sidx)
+	if r == nil {
+		return ErrNotLobbyMember
+	}
+	if r.Privacy == protobuf.LobbyPrivacy_LOBBY_PRIVACY_CLOSED {
+		return ErrLobbyPrivate
+	}
+	if r.PlayerCount() >= mmPlayerPerTeam {
+		return ErrLobbyFull
+	}
+	if !available(targetSidx) {
+		return ErrPlayerUnavailable
+	}
+
+	inv := LobbyInvitation{
+		InvitorSidx: sidx,
+		InviteeSidx: targetSidx,
+		LobbyIdx:    r.Idx,
+		Expires:     time.Now().Add(invitationTTL),
+	}
+	if err := addInvitation(inv); err != nil {
+		return err
+	}
+	lobbyNotify(targetSidx, ResponseCode_LobbyInvitation, invitationMessage(inv, r))
+	return nil
+}
 
 // rules:
 // sidx: a player who respond the invitation
 // invitorSidx: a player who previously issues an invitation
 // accept: the respond of invitation which is accept or decline
-func lobbyRespondInvitation(sidx, invitorSidx uint32, accept bool) {}
+// the invitation must be pending, and the invitee joins the lobby of the invitation
+func lobbyRespondInvitation(sidx, invitorSidx server.Handle, accept bool) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	inv, ok := takeInvitation(sidx, invitorSidx)
+	if !ok {
+		return ErrNoInvitation
+	}
+	if !time.Now().Before(inv.Expires) {
+		notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_EXPIRED)
+		return ErrNoInvitation
+	}
+	if !accept {
+		notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_DECLINED)
+		return nil
+	}
+
+	// Invitations are cancelled when the invitor leaves the lobby, so the
+	// lobby of a pending invitation should still exist.
+	pos, ok := standbyPos[inv.LobbyIdx]
+	if !ok {
+		notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+		return ErrNoInvitation
+	}
+	r := &standby[pos]
+	if err := joinLobby(r, sidx, invitorSidx); err != nil {
+		notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+		return err
+	}
+	notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_ACCEPTED)
+	return nil
+}
 
 // rules:
 // sidx: owner of a lobby
 // targetSidx: player inside of owner lobby
-func lobbyKickPlayer(sidx, targetSidx uint32) {}
+func lobbyKickPlayer(sidx, targetSidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	if r.guestIndex(targetSidx) < 0 {
+		return ErrNotLobbyMember
+	}
+
+	removeGuest(r, targetSidx)
+	lobbyNotify(targetSidx, ResponseCode_LobbyUpdate, &response.LobbyUpdate{
+		Event:       protobuf.LobbyEvent_LOBBY_EVENT_KICKED,
+		SubjectSidx: uint32(targetSidx),
+		LobbyIdx:    r.Idx,
+	})
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_KICKED, targetSidx)
+	return nil
+}
+
+// lobbyForget drops the pending invitations and join requests of a player which
+// is not inside of a lobby, e.g. because its session has expired.
+//
+// Parameters:
+//   - sidx: The player session handle.
+func lobbyForget(sidx server.Handle) {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	dropInvitationsTo(sidx)
+	dropInvitationsFrom(sidx)
+	dropJoinRequests(sidx)
+}
+
+// openLobby registers a new standby lobby hosted by the provided player. The
+// host is not moved into the lobby, see enterLobby. lobbyMutex must be held.
+func openLobby(host server.Handle, mode uint8) *LobbyRoom {
+	lobbyIdx++
+	standbyPos[lobbyIdx] = len(standby)
+	standby = append(standby, LobbyRoom{Mode: mode, Idx: lobbyIdx, HostSidx: host, HostJoined: time.Now()})
+	return &standby[len(standby)-1]
+}
+
+// closeLobby unregisters a standby lobby whose members have already left it.
+// The pointers to the standby lobbies are invalidated. lobbyMutex must be held.
+func closeLobby(r *LobbyRoom) {
+	i := standbyPos[r.Idx]
+	delete(standbyPos, r.Idx)
+	last := len(standby) - 1
+	if i != last {
+		standby[i] = standby[last]
+		standbyPos[standby[i].Idx] = i
+	}
+	standby[last] = LobbyRoom{}
+	standby = standby[:last]
+}
+
+// lobbyOf returns the standby lobby the provided player belongs to, or nil if
+// the player is not inside of a lobby. lobbyMutex must be held.
+func lobbyOf(sidx server.Handle) *LobbyRoom {
+	idx, ok := lobbyMembers[sidx]
+	if !ok {
+		return nil
+	}
+	return &standby[standbyPos[idx]]
+}
+
+// hostedLobby returns the standby lobby hosted by the provided player.
+// lobbyMutex must be held.
+func hostedLobby(sidx server.Handle) (*LobbyRoom, error) {
+	r := lobbyOf(sidx)
+	if r == nil {
+		return nil, ErrNotLobbyMember
+	}
+	if r.HostSidx != sidx {
+		return nil, ErrNotLobbyHost
+	}
+	return r, nil
+}
+
+// available reports whether the provided player has an idle session and may
+// join a lobby. lobbyMutex must be held.
+func available(sidx server.Handle) bool {
+	if _, ok := lobbyMembers[sidx]; ok {
+		return false
+	}
+	s := server.SharedSession().Get(sidx)
+	if s == nil {
+		return false
+	}
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	return s.GameState == server.GameState_Idle
+}
+
+// enterLobby moves the idle session of the provided player into the lobby, with
+// the lobby idx as session state index, and drops its pending invitations and
+// join requests. lobbyMutex must be held.
+func enterLobby(sidx server.Handle, r *LobbyRoom) error {
+	if _, ok := lobbyMembers[sidx]; ok {
+		return ErrPlayerUnavailable
+	}
+	s := server.SharedSession().Get(sidx)
+	if s == nil {
+		return ErrPlayerUnavailable
+	}
+
+	s.Mutex.Lock()
+	if s.GameState != server.GameState_Idle {
+		s.Mutex.Unlock()
+		return ErrPlayerUnavailable
+	}
+	s.GameState = server.GameState_Lobby
+	s.StateIdx = int(r.Idx)
+	s.Mutex.Unlock()
+
+	lobbyMembers[sidx] = r.Idx
+	dropInvitationsTo(sidx)
+	dropJoinRequests(sidx)
+	return nil
+}
+
+// exitLobby moves the session of the provided player out of the lobby back to
+// idle, and drops the invitations it has issued. The session is left untouched
+// if it has expired or moved on. lobbyMutex must be held.
+func exitLobby(sidx server.Handle, r *LobbyRoom) {
+	delete(lobbyMembers, sidx)
+	dropInvitationsFrom(sidx)
+
+	s := server.SharedSession().Get(sidx)
+	if s == nil {
+		return
+	}
+	s.Mutex.Lock()
+	if s.GameState == server.GameState_Lobby && s.StateIdx == int(r.Idx) {
+		s.GameState = server.GameState_Idle
+		s.StateIdx = -1
+	}
+	s.Mutex.Unlock()
+}
+
+// joinLobby moves the provided player into the lobby as a guest, and notifies
+// the player with ResponseCode_JoinLobby and the other members with
+// ResponseCode_LobbyUpdate. lobbyMutex must be held.
+func joinLobby(r *LobbyRoom, sidx, invitedBy server.Handle) error {
+	if r.PlayerCount() >= mmPlayerPerTeam {
+		return ErrLobbyFull
+	}
+	if err := enterLobby(sidx, r); err != nil {
+		return err
+	}
+	r.Guests = append(r.Guests, LobbyGuest{Sidx: sidx, InvitedBySidx: invitedBy, Joined: time.Now()})
+
+	var b [mmPlayerPerTeam]server.Handle
+	join := &response.JoinLobby{
+		LobbyIdx: r.Idx,
+		Mode:     uint32(r.Mode),
+		HostSidx: uint32(r.HostSidx),
+	}
+	for _, g := range r.Guests {
+		join.GuestSidx = append(join.GuestSidx, uint32(g.Sidx))
+	}
+	lobbyNotify(sidx, ResponseCode_JoinLobby, join)
+	sendChatHistory(r, sidx)
+
+	update, err := proto.Marshal(lobbyUpdate(r, protobuf.LobbyEvent_LOBBY_EVENT_JOINED, sidx))
+	if err != nil {
+		return nil
+	}
+	for _, m := range r.PlayerSidx(b[:0]) {
+		if m != sidx {
+			lobbySend(m, ResponseCode_LobbyUpdate, update)
+		}
+	}
+	return nil
+}
+
+// removeGuest removes a guest from the lobby and moves its session back to idle.
+// lobbyMutex must be held.
+func removeGuest(r *LobbyRoom, sidx server.Handle) {
+	i := r.guestIndex(sidx)
+	r.Guests = slices.Delete(r.Guests, i, i+1)
+	exitLobby(sidx, r)
+}
+
+// disbandLobby moves every member of the lobby back to idle, notifies them and
+// unregisters the lobby. lobbyMutex must be held.
+func disbandLobby(r *LobbyRoom, by server.Handle) {
+	var b [mmPlayerPerTeam]server.Handle
+	update, err := proto.Marshal(&response.LobbyUpdate{
+		Event:       protobuf.LobbyEvent_LOBBY_EVENT_DISMISSED,
+		SubjectSidx: uint32(by),
+		LobbyIdx:    r.Idx,
+	})
+	for _, m := range r.PlayerSidx(b[:0]) {
+		exitLobby(m, r)
+		if err == nil {
+			lobbySend(m, ResponseCode_LobbyUpdate, update)
+		}
+	}
+	closeLobby(r)
+}
+
+// dropJoinRequests drops the pending join requests issued by the provided
+// player. lobbyMutex must be held.
+func dropJoinRequests(sidx server.Handle) {
+	for i := range standby {
+		r := &standby[i]
+		if j := slices.Index(r.JoinRequests, sidx); j >= 0 {
+			r.JoinRequests = slices.Delete(r.JoinRequests, j, j+1)
+		}
+	}
+}
+
+// lobbyUpdate returns the ResponseCode_LobbyUpdate message of a lobby event.
+func lobbyUpdate(r *LobbyRoom, event protobuf.LobbyEvent, subject server.Handle) *response.LobbyUpdate {
+	m := &response.LobbyUpdate{
+		Event:       event,
+		SubjectSidx: uint32(subject),
+		LobbyIdx:    r.Idx,
+		Mode:        uint32(r.Mode),
+		HostSidx:    uint32(r.HostSidx),
+		Guests:      make([]*protobuf.LobbyMember, len(r.Guests)),
+		Privacy:     r.Privacy,
+	}
+	for i, g := range r.Guests {
+		m.Guests[i] = &protobuf.LobbyMember{
+			Sidx:          uint32(g.Sidx),
+			Ready:         g.Ready,
+			InvitedBySidx: uint32(g.InvitedBySidx),
+		}
+	}
+	return m
+}
+
+// lobbyBroadcast notifies every member of the lobby of a lobby event with
+// ResponseCode_LobbyUpdate. lobbyMutex must be held, so that the members
+// receive the updates in the order the lobby changed.
+func lobbyBroadcast(r *LobbyRoom, event protobuf.LobbyEvent, subject server.Handle) {
+	p, err := proto.Marshal(lobbyUpdate(r, event, subject))
+	if err != nil {
+		return
+	}
+	var b [mmPlayerPerTeam]server.Handle
+	for _, m := range r.PlayerSidx(b[:0]) {
+		lobbySend(m, ResponseCode_LobbyUpdate, p)
+	}
+}
+
+// lobbyNotify marshals the response message and sends it to the provided player.
+// lobbyMutex must be held, see lobbySend.
+func lobbyNotify(sidx server.Handle, code uint8, m proto.Message) {
+	if s := server.SharedSession().Get(sidx); s != nil {
+		lobbyOutbox.addMessage(s, code, m)
+	}
+}
+
+// lobbySend sends the response payload to the provided player, if its session
+// is still registered. lobbyMutex must be held: the response is sent by
+// lobbyUnlock along the other responses to the player, see sendBatch.
+func lobbySend(sidx server.Handle, code uint8, payload []byte) {
+	if s := server.SharedSession().Get(sidx); s != nil {
+		lobbyOutbox.add(s, code, payload)
+	}
+}
+
+// lobbyUnlock sends the responses queued by lobbySend and lobbyNotify, then
+// unlocks lobbyMutex. The responses are sent before unlocking, so that every
+// player receives the lobby changes in the order they were made.
+func lobbyUnlock() {
+	lobbyOutbox.flush()
+	lobbyMutex.Unlock()
+}
 
 type PlayerConfig struct {
-	Sidx            uint32
+	Sidx            server.Handle
 	TeamSide        uint8
 	SkinId          uint16
 	SpawnEffectId   uint16
@@ -120,7 +689,7 @@ func NewMatchConfig(r []LobbyRoom) MatchConfig {
 	c := 0
 	pc := [mmTotalPlayerSize]PlayerConfig{}
 	for _, l := range r {
-		var bsidx [mmPlayerPerTeam]uint32
+		var bsidx [mmPlayerPerTeam]server.Handle
 		s := l.PlayerSidx(bsidx[:0])
 		t := uint8(c / 5)
 		for _, sidx := range s {
@@ -139,10 +708,16 @@ func NewMatchConfig(r []LobbyRoom) MatchConfig {
 
 // list of standby lobby
 // list of queued lobby -> chan queued -> matchmaking goroutine
-// list of live match (MatchConfig)
+// list of live match (liveMatches)
 
-var standby []LobbyRoom
-var match []MatchConfig
+var (
+	lobbyMutex   sync.Mutex                       // guards the lobby state below
+	standby      []LobbyRoom                      // standby lobbies, in no particular order
+	standbyPos   = make(map[uint32]int)           // lobby idx to its index in standby
+	lobbyMembers = make(map[server.Handle]uint32) // member sidx to its lobby idx
+	lobbyIdx     uint32                           // idx of the last opened lobby
+	lobbyOutbox  sendBatch                        // responses sent while lobbyMutex is held
+)
 
 // test case #1:
 // target = 5
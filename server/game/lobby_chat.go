@@ -0,0 +1,201 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+// ChatFilter moderates the lobby chat messages before they are relayed.
+type ChatFilter interface {
+	// Filter returns the text relayed for a message of the user with the provided
+	// user id, which may be rewritten, e.g. to mask profanity, and false if the
+	// message must not be relayed at all.
+	Filter(uid uint, text string) (string, bool)
+}
+
+const (
+	defaultChatInterval  = time.Second
+	defaultChatBurst     = 5
+	defaultMaxChatLength = 200
+	defaultChatHistory   = 20
+	maxEmote             = math.MaxUint16
+)
+
+var (
+	chatInterval  = defaultChatInterval
+	chatBurst     = defaultChatBurst
+	maxChatLength = defaultMaxChatLength
+	chatHistory   = defaultChatHistory
+	chatFilter    ChatFilter
+
+	// chatLimits holds the rate limit of the chat senders, guarded by lobbyMutex.
+	chatLimits = make(map[server.Handle]*chatLimit)
+)
+
+var (
+	// ErrChatRateLimited is returned for a chat message sent faster than allowed.
+	ErrChatRateLimited = fmt.Errorf("%w: chat rate limited", ErrInvalidState)
+
+	// ErrChatRejected is returned for a chat message rejected by the chat filter.
+	ErrChatRejected = fmt.Errorf("%w: chat message rejected", ErrInvalidRequest)
+)
+
+var (
+	chatRelayedCounter  *common.Counter
+	chatRejectedCounter *common.Counter
+)
+
+func init() {
+	chatRelayedCounter = common.RegisterNewCounter("Lobby Chat Relayed")
+	chatRejectedCounter = common.RegisterNewCounter("Lobby Chat Rejected")
+
+	Register(RequestCode_LobbyChat, States(server.GameState_Lobby, server.GameState_Queueing), lobbyChat)
+}
+
+// lobbyChat validates, rate limits and moderates a chat message before relaying
+// it to the lobby of the request session. The rate limit is checked first, so
+// that the messages rejected by the chat filter are limited as well.
+func lobbyChat(r *Request, m *request.LobbyChat) error {
+	text := m.Text
+	switch {
+	case m.Emote > maxEmote:
+		return fmt.Errorf("%w: emote %d", ErrInvalidRequest, m.Emote)
+	case m.Emote != 0 && text != "":
+		return fmt.Errorf("%w: emote with text", ErrInvalidRequest)
+	case m.Emote == 0 && (text == "" || utf8.RuneCountInString(text) > maxChatLength):
+		return fmt.Errorf("%w: chat message length", ErrInvalidRequest)
+	}
+
+	if err := takeChatToken(r.Session.Sidx); err != nil {
+		return err
+	}
+	if m.Emote == 0 && chatFilter != nil {
+		var ok bool
+		if text, ok = chatFilter.Filter(r.Session.Uid, text); !ok {
+			chatRejectedCounter.Increment()
+			return ErrChatRejected
+		}
+	}
+	return lobbySendChat(r.Session.Sidx, text, m.Emote)
+}
+
+// chatLimit is the token bucket limiting the chat messages of a sender to
+// chatBurst messages at once and one message per chatInterval on average.
+type chatLimit struct {
+	tokens float64
+	last   time.Time
+}
+
+// allow reports whether a message may be sent at the provided time, and
+// consumes a token if so.
+func (l *chatLimit) allow(now time.Time) bool {
+	l.tokens = min(l.tokens+float64(now.Sub(l.last))/float64(chatInterval), float64(chatBurst))
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// takeChatToken consumes a token from the chat rate limit of the provided player.
+//
+// Parameters:
+//   - sidx: The sender, which must be inside of a lobby.
+//
+// Returns:
+//   - error: ErrChatRateLimited if the sender has no token left, otherwise nil.
+func takeChatToken(sidx server.Handle) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	if lobbyOf(sidx) == nil {
+		return ErrNotLobbyMember
+	}
+	now := time.Now()
+	l := chatLimits[sidx]
+	if l == nil {
+		l = &chatLimit{tokens: float64(chatBurst), last: now}
+		chatLimits[sidx] = l
+	}
+	if !l.allow(now) {
+		chatRejectedCounter.Increment()
+		return ErrChatRateLimited
+	}
+	return nil
+}
+
+// lobbySendChat relays a text message or a quick emote to every member of the
+// lobby of the sender, including the sender, and keeps the text messages in the
+// lobby chat history.
+//
+// Parameters:
+//   - sidx: The sender, which must be inside of a lobby.
+//   - text: The text message, empty for a quick emote.
+//   - emote: The quick emote, 0 for a text message.
+//
+// Returns:
+//   - error: The reason the message has not been relayed, otherwise nil.
+func lobbySendChat(sidx server.Handle, text string, emote uint32) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r := lobbyOf(sidx)
+	if r == nil {
+		return ErrNotLobbyMember
+	}
+
+	m := &response.LobbyChat{
+		SenderSidx: uint32(sidx),
+		Text:       text,
+		Emote:      emote,
+		Time:       time.Now().UnixMilli(),
+	}
+	if emote == 0 && chatHistory > 0 {
+		if len(r.chat) == chatHistory {
+			r.chat = append(r.chat[:0], r.chat[1:]...)
+		}
+		r.chat = append(r.chat, m)
+	}
+
+	p, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var b [mmPlayerPerTeam]server.Handle
+	for _, member := range r.PlayerSidx(b[:0]) {
+		lobbySend(member, ResponseCode_LobbyChat, p)
+	}
+	chatRelayedCounter.Increment()
+	return nil
+}
+
+// sendChatHistory sends the lobby chat history to the provided player, if any.
+// lobbyMutex must be held.
+func sendChatHistory(r *LobbyRoom, sidx server.Handle) {
+	if len(r.chat) == 0 {
+		return
+	}
+	lobbyNotify(sidx, ResponseCode_LobbyChatHistory, &response.LobbyChatHistory{
+		Messages: r.chat,
+	})
+}
+
+// releaseChat drops the chat rate limit of a session which has been removed from
+// the session container.
+//
+// Parameters:
+//   - s: The expired session.
+func releaseChat(s *server.Session) {
+	lobbyMutex.Lock()
+	delete(chatLimits, s.Sidx)
+	lobbyUnlock()
+}
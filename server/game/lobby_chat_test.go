@@ -0,0 +1,108 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/server/game/request"
+)
+
+type maskFilter string
+
+func (f maskFilter) Filter(uid uint, text string) (string, bool) {
+	if text == "spam" {
+		return "", false
+	}
+	return strings.ReplaceAll(text, string(f), "***"), true
+}
+
+func TestLobbyChat(t *testing.T) {
+	defer func(f ChatFilter, h int) { chatFilter, chatHistory = f, h }(chatFilter, chatHistory)
+	chatFilter = maskFilter("darn")
+	chatHistory = 2
+
+	s := newTestSessions(t, 2)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	r := &Request{Session: s[0], Code: RequestCode_LobbyChat}
+
+	invalid := []*request.LobbyChat{
+		{},
+		{Text: strings.Repeat("x", maxChatLength+1)},
+		{Text: "hi", Emote: 1},
+		{Emote: maxEmote + 1},
+	}
+	for _, m := range invalid {
+		if err := lobbyChat(r, m); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("%v: expected invalid request, got %v", m, err)
+		}
+	}
+	if err := lobbyChat(r, &request.LobbyChat{Text: "spam"}); !errors.Is(err, ErrChatRejected) {
+		t.Fatalf("expected rejected message, got %v", err)
+	}
+
+	for _, m := range []*request.LobbyChat{{Text: "one"}, {Emote: 3}, {Text: "darn two"}, {Text: "three"}} {
+		if err := lobbyChat(r, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chat := lobbyRoom(host).chat
+	if len(chat) != 2 || chat[0].Text != "*** two" || chat[1].Text != "three" {
+		t.Fatalf("unexpected chat history %v", chat)
+	}
+
+	if err := lobbyChat(&Request{Session: s[1]}, &request.LobbyChat{Text: "hi"}); !errors.Is(err, ErrNotLobbyMember) {
+		t.Fatalf("expected not a lobby member, got %v", err)
+	}
+}
+
+func TestLobbyChatLimit(t *testing.T) {
+	defer func(f ChatFilter) { chatFilter = f }(chatFilter)
+	chatFilter = maskFilter("darn")
+
+	s := newTestSessions(t, 1)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	defer releaseChat(s[0])
+	r := &Request{Session: s[0], Code: RequestCode_LobbyChat}
+
+	// The messages rejected by the chat filter consume the rate limit as well.
+	for i := 0; i < chatBurst; i++ {
+		if err := lobbyChat(r, &request.LobbyChat{Text: "spam"}); !errors.Is(err, ErrChatRejected) {
+			t.Fatalf("expected rejected message, got %v", err)
+		}
+	}
+	if err := lobbyChat(r, &request.LobbyChat{Text: "spam"}); !errors.Is(err, ErrChatRateLimited) {
+		t.Fatalf("expected rate limited message, got %v", err)
+	}
+	if err := lobbyChat(r, &request.LobbyChat{Text: "hi"}); !errors.Is(err, ErrChatRateLimited) {
+		t.Fatalf("expected rate limited message, got %v", err)
+	}
+}
+
+func TestChatLimit(t *testing.T) {
+	now := time.Now()
+	l := &chatLimit{tokens: float64(chatBurst), last: now}
+	for i := 0; i < chatBurst; i++ {
+		if !l.allow(now) {
+			t.Fatalf("message %d of the burst limited", i)
+		}
+	}
+	if l.allow(now) {
+		t.Fatal("message beyond the burst allowed")
+	}
+	if l.allow(now.Add(chatInterval / 2)) {
+		t.Fatal("message allowed before the interval")
+	}
+	if !l.allow(now.Add(chatInterval)) {
+		t.Fatal("message limited after the interval")
+	}
+}
@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+)
+
+func init() {
+	idle := States(server.GameState_Idle)
+	lobby := States(server.GameState_Lobby)
+
+	Register(RequestCode_CreateLobby, idle, createLobby)
+	Register(RequestCode_InviteLobby, lobby, inviteLobby)
+	Register(RequestCode_LeaveLobby, lobby, leaveLobby)
+	Register(RequestCode_AcceptLobbyInvites, idle, acceptLobbyInvites)
+	Register(RequestCode_RequestJoinLobby, idle, requestJoinLobby)
+	Register(RequestCode_RespondJoinLobby, lobby, respondJoinLobby)
+	Register(RequestCode_DismissLobby, lobby, dismissLobby)
+	Register(RequestCode_SetLobbyMode, lobby, setLobbyMode)
+	Register(RequestCode_SetLobbyHost, lobby, setLobbyHost)
+	Register(RequestCode_SetLobbyReady, lobby, setLobbyReady)
+	Register(RequestCode_KickLobbyPlayer, lobby, kickLobbyPlayer)
+}
+
+// lobbyMode validates the lobby mode of a request.
+func lobbyMode(mode uint32) (uint8, error) {
+	if mode > math.MaxUint8 {
+		return 0, fmt.Errorf("%w: lobby mode %d", ErrInvalidRequest, mode)
+	}
+	return uint8(mode), nil
+}
+
+func createLobby(r *Request, m *request.CreateLobby) error {
+	mode, err := lobbyMode(m.Mode)
+	if err != nil {
+		return err
+	}
+	return lobbyCreate(r.Session.Sidx, mode)
+}
+
+func inviteLobby(r *Request, m *request.InviteLobby) error {
+	return lobbyInvitePlayer(r.Session.Sidx, server.Handle(m.InviteeSidx))
+}
+
+func leaveLobby(r *Request, _ *request.LeaveLobby) error {
+	return lobbyLeave(r.Session.Sidx)
+}
+
+func acceptLobbyInvites(r *Request, m *request.AcceptLobbyInvites) error {
+	return lobbyRespondInvitation(r.Session.Sidx, server.Handle(m.InvitorSidx), m.Accept)
+}
+
+func requestJoinLobby(r *Request, m *request.RequestJoinLobby) error {
+	return lobbyRequestJoin(r.Session.Sidx, server.Handle(m.LobbySidx))
+}
+
+func respondJoinLobby(r *Request, m *request.RespondJoinLobby) error {
+	return lobbyRespondJoinRequest(r.Session.Sidx, server.Handle(m.RequesterSidx), m.Accept)
+}
+
+func dismissLobby(r *Request, _ *request.DismissLobby) error {
+	return lobbyDismiss(r.Session.Sidx)
+}
+
+func setLobbyMode(r *Request, m *request.SetLobbyMode) error {
+	mode, err := lobbyMode(m.Mode)
+	if err != nil {
+		return err
+	}
+	return lobbySetMode(r.Session.Sidx, mode)
+}
+
+func setLobbyHost(r *Request, m *request.SetLobbyHost) error {
+	return lobbySetHost(r.Session.Sidx, server.Handle(m.HostSidx))
+}
+
+func setLobbyReady(r *Request, m *request.SetLobbyReady) error {
+	return lobbySetReady(r.Session.Sidx, m.Ready)
+}
+
+func kickLobbyPlayer(r *Request, m *request.KickLobbyPlayer) error {
+	return lobbyKickPlayer(r.Session.Sidx, server.Handle(m.Sidx))
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server/game/response"
+)
+
+// HostMigrationPolicy selects the guest promoted to host when the host of a
+// lobby leaves or its session expires.
+type HostMigrationPolicy uint8
+
+const (
+	// HostMigrationLongestMember promotes the guest who joined the lobby first.
+	HostMigrationLongestMember HostMigrationPolicy = iota
+
+	// HostMigrationReadyFirst promotes the ready guest who joined the lobby first,
+	// or the guest who joined first if no guest is ready.
+	HostMigrationReadyFirst
+)
+
+// hostMigration is set by RunGameServer.
+var hostMigration = HostMigrationLongestMember
+
+// migrateHost moves the host of the lobby out of it and promotes the guest
+// chosen by hostMigration in its place, then notifies the previous host and
+// every member. The lobby must have a guest. lobbyMutex must be held.
+func migrateHost(r *LobbyRoom) {
+	prev := r.HostSidx
+	i := successor(r)
+	g := r.Guests[i]
+	r.Guests = slices.Delete(r.Guests, i, i+1)
+	r.HostSidx = g.Sidx
+	r.HostJoined = g.Joined
+	exitLobby(prev, r)
+
+	lobbyNotify(prev, ResponseCode_LobbyUpdate, &response.LobbyUpdate{
+		Event:       protobuf.LobbyEvent_LOBBY_EVENT_LEFT,
+		SubjectSidx: uint32(prev),
+		LobbyIdx:    r.Idx,
+	})
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_HOST_MIGRATED, g.Sidx)
+}
+
+// successor returns the index in r.Guests of the guest chosen by hostMigration
+// to become the host of the lobby, or -1 if the lobby has no guest.
+func successor(r *LobbyRoom) int {
+	best := -1
+	for i, g := range r.Guests {
+		if best < 0 {
+			best = i
+			continue
+		}
+		b := r.Guests[best]
+		if hostMigration == HostMigrationReadyFirst && g.Ready != b.Ready {
+			if g.Ready {
+				best = i
+			}
+			continue
+		}
+		if g.Joined.Before(b.Joined) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,142 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+func TestHostMigration(t *testing.T) {
+	defer func(p HostMigrationPolicy) { hostMigration = p }(hostMigration)
+
+	tests := []struct {
+		policy HostMigrationPolicy
+		ready  int // guest which is ready, in join order
+		want   int // promoted guest, in join order
+	}{
+		{HostMigrationLongestMember, 1, 0},
+		{HostMigrationReadyFirst, 1, 1},
+		{HostMigrationReadyFirst, -1, 0},
+	}
+	for _, tt := range tests {
+		hostMigration = tt.policy
+		s := newTestSessions(t, 4)
+		host := s[0].Sidx
+		if err := lobbyCreate(host, 0); err != nil {
+			t.Fatal(err)
+		}
+		for _, g := range s[1:] {
+			if err := lobbyInvitePlayer(host, g.Sidx); err != nil {
+				t.Fatal(err)
+			}
+			if err := lobbyRespondInvitation(g.Sidx, host, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		idx := int(lobbyRoom(host).Idx)
+		if tt.ready >= 0 {
+			if err := lobbySetReady(s[1+tt.ready].Sidx, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// Handing the host over and back keeps the join order of the guests.
+		if err := lobbySetHost(host, s[3].Sidx); err != nil {
+			t.Fatal(err)
+		}
+		if err := lobbySetHost(s[3].Sidx, host); err != nil {
+			t.Fatal(err)
+		}
+
+		// The host leaving through an expired session migrates the lobby too.
+		server.SharedSession().Expire(s[0])
+		release(s[0])
+
+		want := s[1+tt.want].Sidx
+		r := lobbyRoom(want)
+		if r.HostSidx != want || r.PlayerCount() != 3 || r.guestIndex(want) >= 0 {
+			t.Errorf("policy %d: unexpected lobby after migration %+v", tt.policy, r)
+		}
+		for _, g := range s[1:] {
+			checkLobbyState(t, g, server.GameState_Lobby, idx)
+		}
+		lobbyDismiss(want)
+	}
+}
+
+func TestMatchDisconnectLeavesLobby(t *testing.T) {
+	s := newTestSessions(t, 3)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range s[1:] {
+		if err := lobbyInvitePlayer(host, g.Sidx); err != nil {
+			t.Fatal(err)
+		}
+		if err := lobbyRespondInvitation(g.Sidx, host, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	idx := lobbyRoom(host).Idx
+	lm := startMatch(NewMatchConfig([]LobbyRoom{lobbyRoom(host)}))
+	defer endMatch(lm.config.Id)
+	for _, p := range s {
+		checkLobbyState(t, p, server.GameState_Match, int(lm.config.Id))
+	}
+
+	// The host and then a guest disconnect during the match.
+	for _, p := range s[:2] {
+		server.SharedSession().Expire(p)
+		release(p)
+		if r := lobbyRoom(p.Sidx); r.Idx != 0 {
+			t.Fatalf("sidx %d still in lobby %d", p.Sidx, r.Idx)
+		}
+	}
+	r := lobbyRoom(s[2].Sidx)
+	if r.Idx != idx || r.HostSidx != s[2].Sidx || r.PlayerCount() != 1 {
+		t.Fatalf("unexpected lobby after the disconnects %+v", r)
+	}
+
+	// The last player disconnecting disbands the lobby.
+	server.SharedSession().Expire(s[2])
+	release(s[2])
+	lobbyMutex.Lock()
+	_, ok := standbyPos[idx]
+	lobbyMutex.Unlock()
+	if ok {
+		t.Fatal("lobby not disbanded")
+	}
+}
+
+func TestMatchEndReturnsToLobby(t *testing.T) {
+	s := newTestSessions(t, 3)
+	host, guest := s[0].Sidx, s[1].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyInvitePlayer(host, guest); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondInvitation(guest, host, true); err != nil {
+		t.Fatal(err)
+	}
+	idx := int(lobbyRoom(host).Idx)
+	c := NewMatchConfig([]LobbyRoom{lobbyRoom(host)})
+	c.PlayerConfigs[2].Sidx = s[2].Sidx // a player without a lobby
+	lm := startMatch(c)
+	endMatch(lm.config.Id)
+
+	checkLobbyState(t, s[0], server.GameState_Lobby, idx)
+	checkLobbyState(t, s[1], server.GameState_Lobby, idx)
+	checkLobbyState(t, s[2], server.GameState_Idle, -1)
+
+	// The lobby members may leave the lobby after the match.
+	if err := lobbyLeave(guest); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[1], server.GameState_Idle, -1)
+	if err := lobbyDismiss(host); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[0], server.GameState_Idle, -1)
+}
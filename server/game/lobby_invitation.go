@@ -0,0 +1,204 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+)
+
+const (
+	defaultInvitationTTL    = 30 * time.Second
+	defaultMaxInvitations   = 8
+	invitationSweepInterval = time.Second
+)
+
+var (
+	invitationTTL  = defaultInvitationTTL
+	maxInvitations = defaultMaxInvitations
+
+	// invitations holds the pending invitations by invitee, oldest first, and
+	// invitationsSent the number of pending invitations by invitor. Both are
+	// guarded by lobbyMutex.
+	invitations     = make(map[server.Handle][]LobbyInvitation)
+	invitationsSent = make(map[server.Handle]int)
+)
+
+var (
+	// ErrTooManyInvitations is returned when an invitor already has maxInvitations
+	// pending invitations.
+	ErrTooManyInvitations = fmt.Errorf("%w: too many pending invitations", ErrInvalidState)
+
+	// ErrAlreadyInvited is returned when the invitee already has a pending
+	// invitation of the invitor, which is not notified again.
+	ErrAlreadyInvited = fmt.Errorf("%w: player already invited", ErrInvalidRequest)
+)
+
+func init() {
+	Register(RequestCode_ListLobbyInvitations, AnyState, listLobbyInvitations)
+}
+
+// listLobbyInvitations replies with the pending invitations of the request session.
+func listLobbyInvitations(r *Request, _ *request.ListLobbyInvitations) error {
+	m := &response.LobbyInvitations{}
+
+	lobbyMutex.Lock()
+	now := time.Now()
+	for _, inv := range invitations[r.Session.Sidx] {
+		// An invitation to a lobby which no longer exists is left to be
+		// cancelled by its response or expiry.
+		if pos, ok := standbyPos[inv.LobbyIdx]; ok && now.Before(inv.Expires) {
+			m.Invitations = append(m.Invitations, invitationMessage(inv, &standby[pos]))
+		}
+	}
+	lobbyUnlock()
+
+	r.Reply(ResponseCode_LobbyInvitations, m)
+	return nil
+}
+
+// addInvitation stores a pending invitation. An invitation of the same invitor
+// to the same invitee which is still pending is kept as is. lobbyMutex must be held.
+//
+// Returns:
+//   - error: ErrAlreadyInvited if the invitation is already pending,
+//     ErrTooManyInvitations if the invitor has reached maxInvitations,
+//     otherwise nil.
+func addInvitation(inv LobbyInvitation) error {
+	pending := invitations[inv.InviteeSidx]
+	if slices.ContainsFunc(pending, func(p LobbyInvitation) bool {
+		return p.InvitorSidx == inv.InvitorSidx
+	}) {
+		return ErrAlreadyInvited
+	}
+	if invitationsSent[inv.InvitorSidx] >= maxInvitations {
+		return ErrTooManyInvitations
+	}
+	invitations[inv.InviteeSidx] = append(pending, inv)
+	invitationsSent[inv.InvitorSidx]++
+	return nil
+}
+
+// takeInvitation removes the pending invitation of the invitor to the invitee.
+// lobbyMutex must be held.
+//
+// Returns:
+//   - LobbyInvitation: The removed invitation.
+//   - bool: True if the invitation was pending, otherwise false.
+func takeInvitation(invitee, invitor server.Handle) (LobbyInvitation, bool) {
+	pending := invitations[invitee]
+	i := slices.IndexFunc(pending, func(p LobbyInvitation) bool {
+		return p.InvitorSidx == invitor
+	})
+	if i < 0 {
+		return LobbyInvitation{}, false
+	}
+	inv := pending[i]
+	if len(pending) == 1 {
+		delete(invitations, invitee)
+	} else {
+		invitations[invitee] = slices.Delete(pending, i, i+1)
+	}
+	uncountInvitation(inv)
+	return inv, true
+}
+
+// cancelInvitations removes the pending invitations matching pred and notifies
+// both sides with the provided status. lobbyMutex must be held.
+func cancelInvitations(pred func(LobbyInvitation) bool, status protobuf.InvitationStatus) {
+	for invitee, pending := range invitations {
+		pending = slices.DeleteFunc(pending, func(inv LobbyInvitation) bool {
+			if !pred(inv) {
+				return false
+			}
+			uncountInvitation(inv)
+			notifyInvitation(inv, status)
+			return true
+		})
+		if len(pending) == 0 {
+			delete(invitations, invitee)
+		} else {
+			invitations[invitee] = pending
+		}
+	}
+}
+
+// dropInvitationsTo cancels the pending invitations to the provided player.
+// lobbyMutex must be held.
+func dropInvitationsTo(invitee server.Handle) {
+	pending := invitations[invitee]
+	if len(pending) == 0 {
+		return
+	}
+	delete(invitations, invitee)
+	for _, inv := range pending {
+		uncountInvitation(inv)
+		notifyInvitation(inv, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+	}
+}
+
+// dropInvitationsFrom cancels the pending invitations issued by the provided
+// player. lobbyMutex must be held.
+func dropInvitationsFrom(invitor server.Handle) {
+	if invitationsSent[invitor] == 0 {
+		return
+	}
+	cancelInvitations(func(inv LobbyInvitation) bool {
+		return inv.InvitorSidx == invitor
+	}, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+}
+
+// uncountInvitation decrements the pending invitation count of the invitor.
+// lobbyMutex must be held.
+func uncountInvitation(inv LobbyInvitation) {
+	if n := invitationsSent[inv.InvitorSidx]; n > 1 {
+		invitationsSent[inv.InvitorSidx] = n - 1
+	} else {
+		delete(invitationsSent, inv.InvitorSidx)
+	}
+}
+
+// invitationExpirer expires the invitations not answered within invitationTTL.
+func invitationExpirer() {
+	t := time.NewTicker(invitationSweepInterval)
+	for now := range t.C {
+		expireInvitations(now)
+	}
+}
+
+func expireInvitations(now time.Time) {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	cancelInvitations(func(inv LobbyInvitation) bool {
+		return !now.Before(inv.Expires)
+	}, protobuf.InvitationStatus_INVITATION_STATUS_EXPIRED)
+}
+
+// notifyInvitation notifies both the invitor and the invitee that an invitation
+// is no longer pending.
+func notifyInvitation(inv LobbyInvitation, status protobuf.InvitationStatus) {
+	m := &response.LobbyInvitationUpdate{
+		Status:      status,
+		InvitorSidx: uint32(inv.InvitorSidx),
+		InviteeSidx: uint32(inv.InviteeSidx),
+		LobbyIdx:    inv.LobbyIdx,
+	}
+	lobbyNotify(inv.InvitorSidx, ResponseCode_LobbyInvitationUpdate, m)
+	lobbyNotify(inv.InviteeSidx, ResponseCode_LobbyInvitationUpdate, m)
+}
+
+// invitationMessage returns the ResponseCode_LobbyInvitation message of a
+// pending invitation to the provided lobby.
+func invitationMessage(inv LobbyInvitation, r *LobbyRoom) *response.LobbyInvitation {
+	return &response.LobbyInvitation{
+		InvitorSidx: uint32(inv.InvitorSidx),
+		LobbyIdx:    inv.LobbyIdx,
+		Mode:        uint32(r.Mode),
+		Expires:     inv.Expires.UnixMilli(),
+	}
+}
@@ -0,0 +1,162 @@
+package game
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+func pendingInvitations(invitee server.Handle) []LobbyInvitation {
+	lobbyMutex.Lock()
+	defer lobbyMutex.Unlock()
+	return append([]LobbyInvitation(nil), invitations[invitee]...)
+}
+
+func TestInvitationLimits(t *testing.T) {
+	defer func(n int) { maxInvitations = n }(maxInvitations)
+	maxInvitations = 2
+
+	s := newTestSessions(t, 4)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+
+	for _, p := range s[1:3] {
+		if err := lobbyInvitePlayer(host, p.Sidx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := lobbyInvitePlayer(host, s[1].Sidx); !errors.Is(err, ErrAlreadyInvited) {
+		t.Fatalf("expected already invited, got %v", err)
+	}
+	if err := lobbyInvitePlayer(host, s[3].Sidx); !errors.Is(err, ErrTooManyInvitations) {
+		t.Fatalf("expected too many invitations, got %v", err)
+	}
+	if err := lobbyRespondInvitation(s[1].Sidx, host, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyInvitePlayer(host, s[3].Sidx); err != nil {
+		t.Fatalf("declined invitation still counted: %v", err)
+	}
+	if n := len(pendingInvitations(s[1].Sidx)); n != 0 {
+		t.Fatalf("%d invitations pending after decline", n)
+	}
+}
+
+func TestInvitationExpiry(t *testing.T) {
+	s := newTestSessions(t, 3)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+
+	for _, p := range s[1:] {
+		if err := lobbyInvitePlayer(host, p.Sidx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	inv := pendingInvitations(s[1].Sidx)
+	if len(inv) != 1 || inv[0].InvitorSidx != host || inv[0].LobbyIdx != lobbyRoom(host).Idx {
+		t.Fatalf("unexpected pending invitations %+v", inv)
+	}
+
+	expireInvitations(inv[0].Expires.Add(-time.Millisecond))
+	if len(pendingInvitations(s[1].Sidx)) != 1 {
+		t.Fatal("invitation expired early")
+	}
+	expireInvitations(inv[0].Expires)
+	if len(pendingInvitations(s[1].Sidx)) != 0 {
+		t.Fatal("invitation did not expire")
+	}
+	if err := lobbyRespondInvitation(s[1].Sidx, host, true); !errors.Is(err, ErrNoInvitation) {
+		t.Fatalf("expected no invitation, got %v", err)
+	}
+
+	expireInvitations(time.Now().Add(invitationTTL))
+	lobbyMutex.Lock()
+	n := invitationsSent[host]
+	lobbyMutex.Unlock()
+	if n != 0 {
+		t.Fatalf("%d invitations counted after expiry", n)
+	}
+}
+
+func TestInvitationCancellation(t *testing.T) {
+	s := newTestSessions(t, 4)
+	host, guest := s[0].Sidx, s[1].Sidx
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	if err := lobbyInvitePlayer(host, guest); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondInvitation(guest, host, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// The invitations of a guest are cancelled when it leaves the lobby.
+	if err := lobbyInvitePlayer(guest, s[2].Sidx); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyLeave(guest); err != nil {
+		t.Fatal(err)
+	}
+	if len(pendingInvitations(s[2].Sidx)) != 0 {
+		t.Fatal("invitation of a former member is still pending")
+	}
+
+	// The invitations to a player are cancelled when it joins another lobby.
+	if err := lobbyInvitePlayer(host, s[2].Sidx); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyCreate(s[2].Sidx, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(s[2].Sidx)
+	if len(pendingInvitations(s[2].Sidx)) != 0 {
+		t.Fatal("invitation to a lobby member is still pending")
+	}
+
+	// The invitations of a lobby are cancelled when it enters the queue.
+	if err := lobbyInvitePlayer(host, s[3].Sidx); err != nil {
+		t.Fatal(err)
+	}
+	lobbySetMatchmaking(host, true)
+	if len(pendingInvitations(s[3].Sidx)) != 0 {
+		t.Fatal("invitation of a queued lobby is still pending")
+	}
+}
+
+func TestInvitationToMissingLobby(t *testing.T) {
+	s := newTestSessions(t, 2)
+	invitor, invitee := s[0].Sidx, s[1].Sidx
+
+	// No lobby is ever registered under index 0.
+	lobbyMutex.Lock()
+	err := addInvitation(LobbyInvitation{
+		InvitorSidx: invitor,
+		InviteeSidx: invitee,
+		Expires:     time.Now().Add(invitationTTL),
+	})
+	lobbyMutex.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := listLobbyInvitations(&Request{Session: s[1]}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondInvitation(invitee, invitor, true); !errors.Is(err, ErrNoInvitation) {
+		t.Fatalf("expected no invitation, got %v", err)
+	}
+	if len(pendingInvitations(invitee)) != 0 {
+		t.Fatal("invitation to a missing lobby is still pending")
+	}
+	checkLobbyState(t, s[1], server.GameState_Idle, -1)
+}
@@ -0,0 +1,187 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+)
+
+// Friendships reports the friendships of the users, used to gate the join
+// requests of friends-of-members lobbies.
+type Friendships interface {
+	// Friends reports whether the users with the provided user ids are friends.
+	Friends(uid, other uint) bool
+}
+
+const maxSearchResults = 20
+
+// friendships is set by RunGameServer, nobody is a friend if nil, so that the
+// friends-of-members lobbies reject every join request.
+var friendships Friendships
+
+// ErrLobbyPrivate is returned for a join request or an invitation not allowed by
+// the lobby privacy.
+var ErrLobbyPrivate = fmt.Errorf("%w: not allowed by the lobby privacy", ErrInvalidState)
+
+func init() {
+	Register(RequestCode_SetLobbyPrivacy, States(server.GameState_Lobby), setLobbyPrivacy)
+	Register(RequestCode_ListJoinRequests, States(server.GameState_Lobby), listJoinRequests)
+	Register(RequestCode_SearchLobbies, States(server.GameState_Idle), searchLobbies)
+}
+
+func setLobbyPrivacy(r *Request, m *request.SetLobbyPrivacy) error {
+	if _, ok := protobuf.LobbyPrivacy_name[int32(m.Privacy)]; !ok {
+		return fmt.Errorf("%w: lobby privacy %d", ErrInvalidRequest, m.Privacy)
+	}
+	return lobbySetPrivacy(r.Session.Sidx, m.Privacy)
+}
+
+// listJoinRequests replies with the pending join requests of the lobby hosted by
+// the request session.
+func listJoinRequests(r *Request, _ *request.ListJoinRequests) error {
+	m := &response.LobbyJoinRequests{}
+
+	lobbyMutex.Lock()
+	l, err := hostedLobby(r.Session.Sidx)
+	if err == nil {
+		for _, sidx := range l.JoinRequests {
+			m.Requests = append(m.Requests, &response.LobbyJoinRequest{
+				RequesterSidx: uint32(sidx),
+				LobbyIdx:      l.Idx,
+			})
+		}
+	}
+	lobbyUnlock()
+
+	if err != nil {
+		return err
+	}
+	r.Reply(ResponseCode_LobbyJoinRequests, m)
+	return nil
+}
+
+// searchLobbies replies with the open lobbies of the requested mode.
+func searchLobbies(r *Request, m *request.SearchLobbies) error {
+	mode, err := lobbyMode(m.Mode)
+	if err != nil {
+		return err
+	}
+	limit := maxSearchResults
+	if m.Limit > 0 && m.Limit < uint32(limit) {
+		limit = int(m.Limit)
+	}
+	r.Reply(ResponseCode_LobbySearch, &response.LobbySearch{
+		Lobbies: lobbySearch(mode, limit),
+	})
+	return nil
+}
+
+// rules:
+// sidx: owner of a lobby
+// privacy: privacy to set
+// the pending join requests are accepted while there is room if the lobby becomes
+// open, the rest remain pending, and declined if it no longer allows join
+// requests. The pending invitations are cancelled if the lobby becomes closed.
+func lobbySetPrivacy(sidx server.Handle, privacy protobuf.LobbyPrivacy) error {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	r, err := hostedLobby(sidx)
+	if err != nil {
+		return err
+	}
+	if r.Privacy == privacy {
+		return nil
+	}
+	r.Privacy = privacy
+	lobbyBroadcast(r, protobuf.LobbyEvent_LOBBY_EVENT_PRIVACY, sidx)
+
+	switch privacy {
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_OPEN:
+		pending := r.JoinRequests
+		r.JoinRequests = nil
+		for i, p := range pending {
+			if r.PlayerCount() >= mmPlayerPerTeam {
+				r.JoinRequests = pending[i:]
+				break
+			}
+			// A requester which is no longer available is dropped.
+			joinLobby(r, p, sidx)
+		}
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_CLOSED:
+		cancelInvitations(func(inv LobbyInvitation) bool {
+			return inv.LobbyIdx == r.Idx
+		}, protobuf.InvitationStatus_INVITATION_STATUS_CANCELLED)
+		declineJoinRequests(r, sidx)
+	case protobuf.LobbyPrivacy_LOBBY_PRIVACY_INVITE_ONLY:
+		declineJoinRequests(r, sidx)
+	}
+	return nil
+}
+
+// lobbySearch returns the open lobbies of the provided mode with room for
+// another player.
+//
+// Parameters:
+//   - mode: The lobby mode.
+//   - limit: The maximum number of lobbies returned.
+//
+// Returns:
+//   - []*protobuf.LobbySummary: The summaries of the found lobbies.
+func lobbySearch(mode uint8, limit int) []*protobuf.LobbySummary {
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+
+	var found []*protobuf.LobbySummary
+	for i := range standby {
+		r := &standby[i]
+		if r.Mode != mode || r.Privacy != protobuf.LobbyPrivacy_LOBBY_PRIVACY_OPEN || r.PlayerCount() >= mmPlayerPerTeam {
+			continue
+		}
+		found = append(found, &protobuf.LobbySummary{
+			LobbyIdx: r.Idx,
+			Mode:     uint32(r.Mode),
+			HostSidx: uint32(r.HostSidx),
+			Players:  uint32(r.PlayerCount()),
+		})
+		if len(found) == limit {
+			break
+		}
+	}
+	return found
+}
+
+// friendOfMember reports whether the provided player is a friend of a member of
+// the lobby. lobbyMutex must be held.
+func friendOfMember(r *LobbyRoom, sidx server.Handle) bool {
+	if friendships == nil {
+		return false
+	}
+	s := server.SharedSession().Get(sidx)
+	if s == nil {
+		return false
+	}
+	var b [mmPlayerPerTeam]server.Handle
+	for _, m := range r.PlayerSidx(b[:0]) {
+		if ms := server.SharedSession().Get(m); ms != nil && friendships.Friends(s.Uid, ms.Uid) {
+			return true
+		}
+	}
+	return false
+}
+
+// declineJoinRequests declines the pending join requests of the lobby on behalf
+// of the provided player. lobbyMutex must be held.
+func declineJoinRequests(r *LobbyRoom, by server.Handle) {
+	for _, p := range r.JoinRequests {
+		lobbyNotify(p, ResponseCode_LobbyUpdate, &response.LobbyUpdate{
+			Event:       protobuf.LobbyEvent_LOBBY_EVENT_DECLINED,
+			SubjectSidx: uint32(by),
+			LobbyIdx:    r.Idx,
+		})
+	}
+	r.JoinRequests = nil
+}
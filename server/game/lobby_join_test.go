@@ -0,0 +1,170 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+)
+
+type allFriends struct{}
+
+func (allFriends) Friends(uid, other uint) bool { return true }
+
+type friendPairs map[[2]uint]bool
+
+func (f friendPairs) Friends(uid, other uint) bool {
+	return f[[2]uint{uid, other}] || f[[2]uint{other, uid}]
+}
+
+func TestLobbyPrivacy(t *testing.T) {
+	defer func(f Friendships) { friendships = f }(friendships)
+
+	s := newTestSessions(t, 3)
+	host, friend, stranger := s[0].Sidx, s[1].Sidx, s[2].Sidx
+	friendships = friendPairs{{s[0].Uid, s[1].Uid}: true}
+
+	if err := lobbyCreate(host, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+
+	// Join requests are left to the host by default.
+	if err := lobbyRequestJoin(stranger, host); err != nil {
+		t.Fatal(err)
+	}
+	if r := lobbyRoom(host); len(r.JoinRequests) != 1 || r.JoinRequests[0] != stranger {
+		t.Fatalf("unexpected join requests %v", r.JoinRequests)
+	}
+
+	// Pending join requests are declined once the lobby no longer allows them.
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_INVITE_ONLY); err != nil {
+		t.Fatal(err)
+	}
+	if r := lobbyRoom(host); len(r.JoinRequests) != 0 {
+		t.Fatalf("join requests still pending %v", r.JoinRequests)
+	}
+	if err := lobbyRequestJoin(friend, host); !errors.Is(err, ErrLobbyPrivate) {
+		t.Fatalf("expected invite-only lobby to reject join requests, got %v", err)
+	}
+
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_FRIENDS); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRequestJoin(stranger, host); !errors.Is(err, ErrLobbyPrivate) {
+		t.Fatalf("expected stranger to be rejected, got %v", err)
+	}
+	if err := lobbyRequestJoin(friend, host); err != nil {
+		t.Fatal(err)
+	}
+	if r := lobbyRoom(host); len(r.JoinRequests) != 1 || r.JoinRequests[0] != friend {
+		t.Fatalf("unexpected join requests %v", r.JoinRequests)
+	}
+
+	if err := lobbyInvitePlayer(host, stranger); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_CLOSED); err != nil {
+		t.Fatal(err)
+	}
+	if len(pendingInvitations(stranger)) != 0 {
+		t.Fatal("invitation to a closed lobby still pending")
+	}
+	if err := lobbyInvitePlayer(host, stranger); !errors.Is(err, ErrLobbyPrivate) {
+		t.Fatalf("expected closed lobby to reject invitations, got %v", err)
+	}
+}
+
+func TestLobbyOpen(t *testing.T) {
+	defer func(f Friendships) { friendships = f }(friendships)
+	friendships = allFriends{}
+
+	s := newTestSessions(t, mmPlayerPerTeam+2)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 4); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_FRIENDS); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range s[1:3] {
+		if err := lobbyRequestJoin(p.Sidx, host); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if found := lobbySearch(4, maxSearchResults); len(found) != 0 {
+		t.Fatalf("friends-of-members lobby listed %v", found)
+	}
+
+	// Pending join requests are accepted once the lobby becomes open.
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_OPEN); err != nil {
+		t.Fatal(err)
+	}
+	idx := int(lobbyRoom(host).Idx)
+	for _, p := range s[1:3] {
+		checkLobbyState(t, p, server.GameState_Lobby, idx)
+	}
+
+	found := lobbySearch(4, maxSearchResults)
+	if len(found) != 1 || found[0].HostSidx != uint32(host) || found[0].Players != 3 {
+		t.Fatalf("unexpected search result %v", found)
+	}
+	if found := lobbySearch(3, maxSearchResults); len(found) != 0 {
+		t.Fatalf("lobby of another mode listed %v", found)
+	}
+
+	for _, p := range s[3:mmPlayerPerTeam] {
+		if err := lobbyRequestJoin(p.Sidx, host); err != nil {
+			t.Fatal(err)
+		}
+		checkLobbyState(t, p, server.GameState_Lobby, idx)
+	}
+	if err := lobbyRequestJoin(s[mmPlayerPerTeam].Sidx, host); !errors.Is(err, ErrLobbyFull) {
+		t.Fatalf("expected full lobby, got %v", err)
+	}
+	if found := lobbySearch(4, maxSearchResults); len(found) != 0 {
+		t.Fatalf("full lobby listed %v", found)
+	}
+}
+
+func TestLobbyOpenFull(t *testing.T) {
+	s := newTestSessions(t, mmPlayerPerTeam+2)
+	host := s[0].Sidx
+	if err := lobbyCreate(host, 4); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	for _, p := range s[1:] {
+		if err := lobbyRequestJoin(p.Sidx, host); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The join requests which do not fit once the lobby becomes open remain
+	// pending, so that the host can still respond to them.
+	if err := lobbySetPrivacy(host, protobuf.LobbyPrivacy_LOBBY_PRIVACY_OPEN); err != nil {
+		t.Fatal(err)
+	}
+	idx := int(lobbyRoom(host).Idx)
+	for _, p := range s[1:mmPlayerPerTeam] {
+		checkLobbyState(t, p, server.GameState_Lobby, idx)
+	}
+	left := s[mmPlayerPerTeam:]
+	r := lobbyRoom(host)
+	if len(r.JoinRequests) != len(left) || r.JoinRequests[0] != left[0].Sidx || r.JoinRequests[1] != left[1].Sidx {
+		t.Fatalf("unexpected join requests %v", r.JoinRequests)
+	}
+	for _, p := range left {
+		checkLobbyState(t, p, server.GameState_Idle, -1)
+	}
+
+	if err := lobbyLeave(s[1].Sidx); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondJoinRequest(host, left[0].Sidx, true); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, left[0], server.GameState_Lobby, idx)
+}
@@ -0,0 +1,202 @@
+package game
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+func checkLobbyState(t *testing.T, s *server.Session, state int, idx int) {
+	t.Helper()
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	if s.GameState != state || s.StateIdx != idx {
+		t.Errorf("sidx %d: state %d idx %d, want state %d idx %d", s.Sidx, s.GameState, s.StateIdx, state, idx)
+	}
+}
+
+func lobbyRoom(sidx server.Handle) LobbyRoom {
+	lobbyMutex.Lock()
+	defer lobbyMutex.Unlock()
+	r := lobbyOf(sidx)
+	if r == nil {
+		return LobbyRoom{}
+	}
+	return *r
+}
+
+func TestLobbyInvitations(t *testing.T) {
+	s := newTestSessions(t, mmPlayerPerTeam+1)
+	host := s[0].Sidx
+
+	if err := lobbyCreate(host, 2); err != nil {
+		t.Fatal(err)
+	}
+	defer lobbyDismiss(host)
+	r := lobbyRoom(host)
+	checkLobbyState(t, s[0], server.GameState_Lobby, int(r.Idx))
+	if err := lobbyCreate(host, 2); !errors.Is(err, ErrPlayerUnavailable) {
+		t.Fatalf("expected unavailable host, got %v", err)
+	}
+
+	if err := lobbyRespondInvitation(s[1].Sidx, host, true); !errors.Is(err, ErrNoInvitation) {
+		t.Fatalf("expected no invitation, got %v", err)
+	}
+	for _, g := range s[1 : mmPlayerPerTeam+1] {
+		if err := lobbyInvitePlayer(host, g.Sidx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := lobbyRespondInvitation(s[1].Sidx, host, false); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[1], server.GameState_Idle, -1)
+	if err := lobbyInvitePlayer(host, s[1].Sidx); err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range s[1:mmPlayerPerTeam] {
+		if err := lobbyRespondInvitation(g.Sidx, host, true); err != nil {
+			t.Fatal(err)
+		}
+		checkLobbyState(t, g, server.GameState_Lobby, int(r.Idx))
+	}
+	if err := lobbyRespondInvitation(s[mmPlayerPerTeam].Sidx, host, true); !errors.Is(err, ErrLobbyFull) {
+		t.Fatalf("expected full lobby, got %v", err)
+	}
+	checkLobbyState(t, s[mmPlayerPerTeam], server.GameState_Idle, -1)
+
+	r = lobbyRoom(host)
+	if r.PlayerCount() != mmPlayerPerTeam || r.Guests[0].InvitedBySidx != host {
+		t.Fatalf("unexpected lobby %+v", r)
+	}
+}
+
+func TestLobbyMembership(t *testing.T) {
+	s := newTestSessions(t, 4)
+	host, a, b, c := s[0].Sidx, s[1].Sidx, s[2].Sidx, s[3].Sidx
+
+	if err := lobbyCreate(host, 1); err != nil {
+		t.Fatal(err)
+	}
+	idx := int(lobbyRoom(host).Idx)
+
+	if err := lobbyRequestJoin(a, host); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRequestJoin(b, a); !errors.Is(err, ErrNotLobbyMember) {
+		t.Fatalf("expected not a lobby member, got %v", err)
+	}
+	if err := lobbyRespondJoinRequest(host, a, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondJoinRequest(host, a, true); !errors.Is(err, ErrNoJoinRequest) {
+		t.Fatalf("expected no join request, got %v", err)
+	}
+	for _, p := range []server.Handle{a, b} {
+		if err := lobbyRequestJoin(p, host); err != nil {
+			t.Fatal(err)
+		}
+		if err := lobbyRespondJoinRequest(host, p, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := lobbyInvitePlayer(a, c); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbyRespondInvitation(c, a, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := lobbySetMode(a, 3); !errors.Is(err, ErrNotLobbyHost) {
+		t.Fatalf("expected not host, got %v", err)
+	}
+	if err := lobbySetReady(a, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := lobbySetHost(host, a); err != nil {
+		t.Fatal(err)
+	}
+	r := lobbyRoom(a)
+	if r.HostSidx != a || r.guestIndex(host) != 0 || r.Guests[0].Ready {
+		t.Fatalf("unexpected lobby after host change %+v", r)
+	}
+
+	if err := lobbyKickPlayer(a, b); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[2], server.GameState_Idle, -1)
+	if err := lobbyLeave(c); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[3], server.GameState_Idle, -1)
+	checkLobbyState(t, s[0], server.GameState_Lobby, idx)
+
+	// The remaining guest becomes the host when the host leaves, and the lobby
+	// is dismissed once it is empty.
+	if err := lobbyLeave(a); err != nil {
+		t.Fatal(err)
+	}
+	checkLobbyState(t, s[1], server.GameState_Idle, -1)
+	if r := lobbyRoom(host); r.HostSidx != host || len(r.Guests) != 0 {
+		t.Fatalf("unexpected lobby after host left %+v", r)
+	}
+	if err := lobbyLeave(host); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range s {
+		checkLobbyState(t, m, server.GameState_Idle, -1)
+	}
+	lobbyMutex.Lock()
+	_, ok := standbyPos[uint32(idx)]
+	lobbyMutex.Unlock()
+	if ok {
+		t.Fatal("dismissed lobby is still registered")
+	}
+}
+
+func TestLobbyConcurrent(t *testing.T) {
+	const hosts = 8
+	s := newTestSessions(t, hosts*mmPlayerPerTeam)
+	for i := 0; i < hosts; i++ {
+		if err := lobbyCreate(s[i].Sidx, 0); err != nil {
+			t.Fatal(err)
+		}
+		defer lobbyDismiss(s[i].Sidx)
+	}
+
+	var wg sync.WaitGroup
+	for _, g := range s[hosts:] {
+		wg.Add(1)
+		go func(g server.Handle) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				host := s[i%hosts].Sidx
+				if lobbyRequestJoin(g, host) == nil {
+					lobbyRespondJoinRequest(host, g, true)
+				}
+				lobbySetReady(g, true)
+				lobbyLeave(g)
+			}
+		}(g.Sidx)
+	}
+	wg.Wait()
+
+	lobbyMutex.Lock()
+	defer lobbyMutex.Unlock()
+	for _, m := range s {
+		idx, ok := lobbyMembers[m.Sidx]
+		state, want := server.GameState_Idle, -1
+		if ok {
+			state, want = server.GameState_Lobby, int(idx)
+		}
+		checkLobbyState(t, m, state, want)
+	}
+	for i := 0; i < hosts; i++ {
+		r := lobbyOf(s[i].Sidx)
+		if r.PlayerCount() > mmPlayerPerTeam || len(r.JoinRequests) != 0 {
+			t.Errorf("lobby %d: %d players, %d join requests", r.Idx, r.PlayerCount(), len(r.JoinRequests))
+		}
+	}
+}
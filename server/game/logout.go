@@ -0,0 +1,17 @@
+package game
+
+import (
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+)
+
+func init() {
+	Register(RequestCode_Logout, AnyState, logout)
+}
+
+// logout expires the request session, which releases its lobby and queue
+// membership through the session container expire hooks.
+func logout(r *Request, _ *request.Logout) error {
+	server.SharedSession().Expire(r.Session)
+	return nil
+}
@@ -0,0 +1,167 @@
+package game
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+// PlayerState represents the latest accepted movement state of a match player.
+type PlayerState struct {
+	Position  [3]float32
+	Rotation  [4]float32
+	Velocity  [3]float32
+	Timestamp uint32    // client clock in milliseconds when the state was sampled
+	Received  time.Time // server time when the state was accepted, zero if none yet
+}
+
+// liveMatch represents a match being played, holding its configuration, the
+// latest accepted state of each player, indexed like MatchConfig.PlayerConfigs,
+// and the player inputs queued by the UDP workers until the next tick.
+type liveMatch struct {
+	mutex     sync.Mutex
+	config    MatchConfig
+	states    [mmTotalPlayerSize]PlayerState
+	inputs    []playerInput
+	spare     []playerInput
+	acks      [mmTotalPlayerSize]uint32
+	clocks    [mmTotalPlayerSize]int64 // server minus client clock in milliseconds
+	history   [rewindHistory]rewindFrame
+	broadcast time.Time
+
+	// Only accessed by the tick worker running the match.
+	tick      uint64
+	next      time.Time
+	sequence  uint32
+	snapshots [snapshotHistory]matchSnapshot
+	outbox    sendBatch // responses of the current tick
+
+	ended atomic.Bool
+}
+
+var (
+	liveMatches = make(map[uint32]*liveMatch)
+	liveMutex   sync.RWMutex
+	liveMatchId atomic.Uint32
+)
+
+// startMatch registers the provided match configuration as a live match under a
+// new match id, moves its players to GameState_Match with the match id as
+// session state index, and schedules the match on the tick scheduler.
+//
+// Parameters:
+//   - c: The match configuration.
+//
+// Returns:
+//   - *liveMatch: The live match.
+func startMatch(c MatchConfig) *liveMatch {
+	now := time.Now()
+	c.Id = liveMatchId.Add(1)
+	c.Begin = now
+	if matchDuration > 0 {
+		c.End = now.Add(matchDuration)
+	}
+	m := &liveMatch{config: c, next: now.Add(tickInterval)}
+
+	liveMutex.Lock()
+	liveMatches[c.Id] = m
+	liveMutex.Unlock()
+
+	for _, pc := range c.PlayerConfigs {
+		s := server.SharedSession().Get(pc.Sidx)
+		if s == nil {
+			continue
+		}
+		s.Mutex.Lock()
+		s.GameState = server.GameState_Match
+		s.StateIdx = int(c.Id)
+		s.Mutex.Unlock()
+	}
+
+	if scheduler != nil {
+		scheduler.schedule(m)
+	}
+	return m
+}
+
+// endMatch unregisters the live match with the provided id, stops its ticks,
+// and moves its players still in the match back to their lobby, which they stay
+// a member of during the match, or to GameState_Idle if they have none.
+func endMatch(id uint32) {
+	liveMutex.Lock()
+	m := liveMatches[id]
+	delete(liveMatches, id)
+	liveMutex.Unlock()
+	if m == nil {
+		return
+	}
+	m.ended.Store(true)
+
+	lobbyMutex.Lock()
+	defer lobbyUnlock()
+	for _, pc := range m.config.PlayerConfigs {
+		s := server.SharedSession().Get(pc.Sidx)
+		if s == nil {
+			continue
+		}
+		s.Mutex.Lock()
+		if s.GameState == server.GameState_Match && s.StateIdx == int(id) {
+			if idx, ok := lobbyMembers[pc.Sidx]; ok {
+				s.GameState = server.GameState_Lobby
+				s.StateIdx = int(idx)
+			} else {
+				s.GameState = server.GameState_Idle
+				s.StateIdx = -1
+			}
+		}
+		s.Mutex.Unlock()
+	}
+}
+
+// sessionMatch returns the live match of the provided session and the index of
+// the session player within the match, or nil if the session is not in a match.
+func sessionMatch(s *server.Session) (*liveMatch, int) {
+	s.Mutex.Lock()
+	state, idx := s.GameState, s.StateIdx
+	s.Mutex.Unlock()
+	if state != server.GameState_Match || idx < 0 {
+		return nil, -1
+	}
+
+	liveMutex.RLock()
+	m := liveMatches[uint32(idx)]
+	liveMutex.RUnlock()
+	if m == nil {
+		return nil, -1
+	}
+
+	if i := m.slot(s.Sidx); i >= 0 {
+		return m, i
+	}
+	return nil, -1
+}
+
+// slot returns the index of the player with the provided session handle within
+// the match, or -1 if the player is not in the match.
+func (m *liveMatch) slot(sidx server.Handle) int {
+	for i, pc := range m.config.PlayerConfigs {
+		if pc.Sidx == sidx {
+			return i
+		}
+	}
+	return -1
+}
+
+// leaveMatch clears the state of a session player which left its match, so the
+// remaining players no longer receive its position.
+func leaveMatch(s *server.Session) {
+	m, i := sessionMatch(s)
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	m.states[i] = PlayerState{}
+	m.mutex.Unlock()
+}
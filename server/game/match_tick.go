@@ -0,0 +1,255 @@
+package game
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
+)
+
+const (
+	defaultTickRate = 30
+
+	// maxCatchUpTicks is the number of ticks a match may run at once to catch
+	// up after a late tick before the remaining ticks are skipped.
+	maxCatchUpTicks = 5
+
+	// maxQueuedInputs bounds the player inputs queued by a match between ticks.
+	maxQueuedInputs = 16 * mmTotalPlayerSize
+)
+
+var (
+	tickInterval  = time.Second / defaultTickRate
+	matchDuration time.Duration
+
+	// scheduler runs the live match ticks, set by RunGameServer.
+	scheduler *tickScheduler
+
+	matchTickCounter        *common.Counter
+	matchTickOverrunCounter *common.Counter
+	matchTickSkippedCounter *common.Counter
+	matchInputDropCounter   *common.Counter
+)
+
+func init() {
+	matchTickCounter = common.RegisterNewCounter("Match Tick")
+	matchTickOverrunCounter = common.RegisterNewCounter("Match Tick Overrun")
+	matchTickSkippedCounter = common.RegisterNewCounter("Match Tick Skipped")
+	matchInputDropCounter = common.RegisterNewCounter("Match Input Dropped")
+}
+
+// playerInput represents a position update queued by a UDP worker for the next
+// tick of the match of the player.
+type playerInput struct {
+	session *server.Session
+	slot    int
+	state   PlayerState
+	ack     uint32
+}
+
+// tickScheduler spreads the live matches over a fixed set of tick workers, so
+// thousands of matches are simulated without a goroutine per match.
+type tickScheduler struct {
+	workers []*tickWorker
+}
+
+// tickWorker runs the ticks of the matches assigned to it on a single goroutine.
+type tickWorker struct {
+	mutex   sync.Mutex
+	matches []*liveMatch
+}
+
+// newTickScheduler creates a tick scheduler and spawns its worker goroutines,
+// which run until the provided context is done.
+//
+// Parameters:
+//   - ctx: The context which stops the tick workers when done.
+//   - nbWorkers: The number of tick workers.
+//   - interval: The fixed tick interval of every match.
+//
+// Returns:
+//   - *tickScheduler: The tick scheduler.
+func newTickScheduler(ctx context.Context, nbWorkers int, interval time.Duration) *tickScheduler {
+	if nbWorkers <= 0 {
+		nbWorkers = 1
+	}
+
+	ts := &tickScheduler{workers: make([]*tickWorker, nbWorkers)}
+	for i := range ts.workers {
+		ts.workers[i] = &tickWorker{}
+		go ts.workers[i].run(ctx, interval)
+	}
+	return ts
+}
+
+// schedule assigns the match to the tick worker running the fewest matches.
+func (ts *tickScheduler) schedule(m *liveMatch) {
+	w := ts.workers[0]
+	n := w.load()
+	for _, o := range ts.workers[1:] {
+		if l := o.load(); l < n {
+			w, n = o, l
+		}
+	}
+
+	w.mutex.Lock()
+	w.matches = append(w.matches, m)
+	w.mutex.Unlock()
+}
+
+func (w *tickWorker) load() int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return len(w.matches)
+}
+
+// run advances the matches of the worker on every interval, dropping the ended
+// matches, and counts an overrun whenever a round takes longer than the interval,
+// until the provided context is done.
+func (w *tickWorker) run(ctx context.Context, interval time.Duration) {
+	var ms []*liveMatch
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		var now time.Time
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-t.C:
+		}
+
+		w.mutex.Lock()
+		ms = append(ms[:0], w.matches...)
+		w.mutex.Unlock()
+
+		ended := false
+		for _, m := range ms {
+			if !m.advance(now) {
+				ended = true
+			}
+		}
+		if ended {
+			w.removeEnded()
+		}
+
+		if time.Since(now) > interval {
+			matchTickOverrunCounter.Increment()
+		}
+	}
+}
+
+func (w *tickWorker) removeEnded() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	ms := w.matches[:0]
+	for _, m := range w.matches {
+		if !m.ended.Load() {
+			ms = append(ms, m)
+		}
+	}
+	clear(w.matches[len(ms):])
+	w.matches = ms
+}
+
+// advance runs the ticks of the match due at the provided time with a fixed
+// timestep, running at most maxCatchUpTicks at once and skipping the rest.
+//
+// Returns:
+//   - bool: False if the match has ended, otherwise true.
+func (m *liveMatch) advance(now time.Time) bool {
+	for i := 0; i < maxCatchUpTicks && !now.Before(m.next); i++ {
+		if !m.step(now) {
+			return false
+		}
+		m.next = m.next.Add(tickInterval)
+	}
+
+	if !now.Before(m.next) {
+		matchTickSkippedCounter.Increment()
+		m.next = now.Add(tickInterval)
+	}
+	return true
+}
+
+// step runs a single tick of the match: it applies the queued player inputs,
+// records the player positions in the rewind history, sends corrections for the
+// rejected inputs, and emits a delta snapshot of the player states at most once
+// per syncPosInterval. The responses of the tick to a player are sent together,
+// see sendBatch. The match is ended once its end time
+// has passed or none of its players is still connected.
+//
+// Returns:
+//   - bool: False if the match has ended, otherwise true.
+func (m *liveMatch) step(now time.Time) bool {
+	if m.ended.Load() {
+		return false
+	}
+	if m.expired(now) {
+		endMatch(m.config.Id)
+		return false
+	}
+
+	m.mutex.Lock()
+	inputs := m.inputs
+	m.inputs = m.spare[:0]
+	var rejected []playerInput
+	for _, in := range inputs {
+		if !m.apply(&in) {
+			rejected = append(rejected, playerInput{session: in.session, slot: in.slot, state: m.states[in.slot]})
+		}
+	}
+	clear(inputs)
+	m.spare = inputs
+	m.record(now)
+
+	due := now.Sub(m.broadcast) >= syncPosInterval
+	var states [mmTotalPlayerSize]PlayerState
+	var acks [mmTotalPlayerSize]uint32
+	if due {
+		m.broadcast = now
+		states = m.states
+		acks = m.acks
+	}
+	m.mutex.Unlock()
+
+	m.tick++
+	matchTickCounter.Increment()
+
+	for _, in := range rejected {
+		p := syncPosResponse(in.session.Sidx, &in.state)
+		m.outbox.addMessage(in.session, ResponseCode_SyncPos, p)
+	}
+	if due {
+		m.emitSnapshot(now, &states, &acks)
+	}
+	m.outbox.flush()
+	return true
+}
+
+// expired reports whether the match end time has passed or none of its
+// players is still registered.
+func (m *liveMatch) expired(now time.Time) bool {
+	if !m.config.End.IsZero() && !now.Before(m.config.End) {
+		return true
+	}
+	for _, pc := range m.config.PlayerConfigs {
+		if server.SharedSession().Get(pc.Sidx) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// queue queues a player input for the next tick, dropping it if the queue is full.
+func (m *liveMatch) queue(in playerInput) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if len(m.inputs) >= maxQueuedInputs {
+		matchInputDropCounter.Increment()
+		return
+	}
+	m.inputs = append(m.inputs, in)
+}
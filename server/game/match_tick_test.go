@@ -0,0 +1,67 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+func TestTickSchedulerEndsMatches(t *testing.T) {
+	defer func(d time.Duration) { matchDuration = d }(matchDuration)
+	matchDuration = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ts := newTickScheduler(ctx, 2, 5*time.Millisecond)
+	var ms []*liveMatch
+	var players []*server.Session
+	for i := 0; i < 4; i++ {
+		c, sessions := newMatchSessions(t)
+		m := startMatch(c)
+		ts.schedule(m)
+		ms = append(ms, m)
+		players = append(players, sessions[0])
+	}
+	for _, w := range ts.workers {
+		if n := w.load(); n != 2 {
+			t.Fatalf("unbalanced tick workers: %d matches", n)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for _, w := range ts.workers {
+		for w.load() != 0 {
+			if time.Now().After(deadline) {
+				t.Fatal("matches still scheduled after their end time")
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	for i, m := range ms {
+		if !m.ended.Load() {
+			t.Errorf("match %d not ended", i)
+		}
+		if lm, _ := sessionMatch(players[i]); lm != nil {
+			t.Errorf("match %d player still in the match", i)
+		}
+	}
+}
+
+func TestMatchAdvanceSkipsBacklog(t *testing.T) {
+	c, _ := newMatchSessions(t)
+	m := startMatch(c)
+	defer endMatch(m.config.Id)
+
+	now := m.next.Add(100 * tickInterval)
+	if !m.advance(now) {
+		t.Fatal("match ended")
+	}
+	if m.tick != maxCatchUpTicks {
+		t.Fatalf("ran %d ticks, want %d", m.tick, maxCatchUpTicks)
+	}
+	if !m.next.After(now) {
+		t.Fatal("backlog not skipped")
+	}
+}
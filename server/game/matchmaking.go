@@ -91,7 +91,7 @@ func findmatch() (mn int, bn int) {
 			l[i] = r[j].Value
 			r[j] = nil
 		}
-		_ = NewMatchConfig(l)
+		startMatch(NewMatchConfig(l))
 		// Update the r buffer with latest structure
 		x := r[:0]
 		p := &LlistNode[LobbyRoom]{next: nil}
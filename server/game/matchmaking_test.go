@@ -25,20 +25,36 @@ import (
 	"time"
 
 	"github.com/bytedance/gopkg/lang/fastrand"
-	"github.com/pemmel/gameserver/server"
 )
 
 func TestMatchmaking(t *testing.T) {
 	a := []int{1, 2, 2, 3, 4}
+	s := newTestSessions(t, 12)
 	for i, v := range a {
 		r := LobbyRoom{
 			Idx:      uint32(i),
-			HostSidx: uint32(0),
+			HostSidx: s[0].Sidx,
 			Guests:   make([]LobbyGuest, v-1),
 		}
-		server.SharedSession().NewSession(server.NewSessionV1, 0)
+		for j := range r.Guests {
+			r.Guests[j].Sidx = s[1+j].Sidx
+		}
+		s = s[v:]
 		mmQueue.Insert(r)
 	}
+
+	// The matches started by findmatch are ended, and the unmatched lobbies are
+	// dropped from the queue.
+	first := liveMatchId.Load() + 1
+	t.Cleanup(func() {
+		for id := first; id <= liveMatchId.Load(); id++ {
+			endMatch(id)
+		}
+		var buf []*LlistNode[LobbyRoom]
+		for mmQueue.Borrow(mmBorrowStride, &buf) > 0 {
+			buf = buf[:0]
+		}
+	})
 	findmatch()
 	for i := mmQueue.next; i != nil; i = i.next {
 		v := i.Value
@@ -3,6 +3,8 @@ package game
 import (
 	"encoding/binary"
 	"net"
+
+	"github.com/pemmel/gameserver/server"
 )
 
 // Game UDP Packet Format:
@@ -10,10 +12,44 @@ import (
 // Header    : [Version] [SIDX] [Sequence Number]
 // Payload   : [Request Code] [Protobuf Data]
 // Version 1 : [1] [SIDX] [Sequence Number] [GCM Auth Tag] [Payload]
+// Version 2 : [2] [SIDX] [Sequence Number] [GCM Auth Tag] [Payload]
+// Version 3 : [3] [SIDX] [Sequence Number] [GCM Auth Tag] [Payload]
 //
 // - Encryption:
-//     Request Code and gRPC Payload are encrypted together using AES256-GCM with the
-// 		 Sequence Number as the nonce.
+//     Request Code and gRPC Payload are encrypted together using AES256-GCM. From
+//     version 3, the nonce is [Role] [Zero Padding] [Sequence Number], where Role is
+//     "CLNT" for client packets and "SRVR" for server packets. Version 1 and 2 keep
+//     the nonce [Sequence Number] [Zero Padding] of their clients, server packets set
+//     the first padding byte to 0x80, so both directions never share a nonce.
+//
+// - Rekey:
+//     Once either direction reaches sequence number 2^31, the server derives the next
+//     session key with HKDF from the shared key and sends ResponseCode_Rekey sealed with
+//     the previous key. Both keys are accepted during a short overlap.
+//
+// - Record Layout:
+//     When the high bit of the Version is set, the packet uses the record layout (packet
+//     layout v2) and the encrypted payload holds one or more records instead of a single
+//     Request Code and Protobuf Data:
+//       Record   : [Code 8] [Flags 8] [Length 16] [Data]
+//       Fragment : [Message Id 16] [Index 8] [Count 8] [Chunk]
+//     A record with the Fragment flag carries one chunk of a message too large for one
+//     datagram, reassembled once all Count chunks of the Message Id have been received.
+//     The low 7 bits of the Version remain the session version. The server only sends
+//     record layout packets to a client once it has sent one, and then packs the
+//     responses queued together for the client, e.g. by a lobby change or a match tick,
+//     into a single datagram.
+//
+// - Reliability:
+//     Packets are unreliable. Codes selected with ReliableRequest and ReliableResponse
+//     may be carried inside RequestCode_Reliable and ResponseCode_Reliable frames, which
+//     add per-channel sequences, acknowledgements, retransmission and ordering. The
+//     layer is optional: requests are also accepted in their plain form, and responses
+//     are only framed once the client has sent a reliable frame.
+//
+// - SIDX:
+//     The session handle, made of the session slot index and its generation, so that
+//     a packet minted for a previous occupant of a reused slot is rejected.
 //
 // - Size (in bits):
 //   - Version: 8
@@ -23,10 +59,13 @@ import (
 //   - GCM Auth Tag: 128
 //   - Protobuf Data: Variable
 //
-// The client encrypts the determined field with the provided ECDH public key.
-// The server validates the request with its server-side ECDH private key corresponding
-// to the packet SIDX requested by the client. Then, the server decodes the gRPC payload
-// according to the request code.
+// Version 1, 2 and 3 share the same layout. On version 1, the auth server generates
+// the AES key and sends it to the client. From version 2, the client and the server
+// derive the AES key from an X25519 key agreement, so the key never crosses the wire.
+// Version 3 only differs from version 2 in the nonce layout.
+// The server validates the request with the session key corresponding to the packet
+// SIDX requested by the client. Then, the server decodes the gRPC payload according
+// to the request code.
 
 const (
 	gcmTagLen      int = 16
@@ -45,14 +84,19 @@ const (
 	payloadBeginPos    int = sequenceNbEndPos
 )
 
+const (
+	recordLayoutFlag uint8 = 0x80
+	versionMask      uint8 = 0x7f
+)
+
 func packetMeaningful(b []byte) bool {
 	if len(b) == 0 {
 		return false
 	}
-	v := b[0]
+	v := b[0] & versionMask
 	switch v {
-	case 1:
-		return len(b) > minPacketLenV1
+	case 1, 2, 3:
+		return len(b) >= minPacketLenV1
 	default:
 		return false
 	}
@@ -72,12 +116,16 @@ func (p *packet) payload() []byte {
 }
 
 func (p *packet) version() uint8 {
-	return p.data[versionBeginPos]
+	return p.data[versionBeginPos] & versionMask
 }
 
-func (p *packet) sidx() uint32 {
+func (p *packet) records() bool {
+	return p.data[versionBeginPos]&recordLayoutFlag != 0
+}
+
+func (p *packet) sidx() server.Handle {
 	s := p.data[sidxBeginPos:sidxEndPos]
-	return binary.BigEndian.Uint32(s)
+	return server.Handle(binary.BigEndian.Uint32(s))
 }
 
 func (p *packet) sequence() uint32 {
@@ -1,12 +1,19 @@
 package game
 
 import (
+	"crypto/ecdh"
+	"crypto/rand"
 	"encoding/binary"
 	"fmt"
-	"math/rand"
+	mrand "math/rand"
+	"net"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
 )
 
 func BenchmarkPacketVerifyV1(b *testing.B) {
@@ -37,10 +44,13 @@ func newPacketAEAD(version uint8, session *server.Session, len int) packet {
 	if session == nil {
 		panic(session)
 	}
+	return newPacketAEADSeq(version, session, session.Key(), mrand.Uint32(), len)
+}
 
-	sidx := binary.BigEndian.AppendUint32(nil, session.Sidx)
-	seqn := binary.BigEndian.AppendUint32(nil, rand.Uint32())
-	code := byte(rand.Int())
+func newPacketAEADSeq(version uint8, session *server.Session, k *server.SessionKey, seq uint32, len int) packet {
+	sidx := binary.BigEndian.AppendUint32(nil, uint32(session.Sidx))
+	seqn := binary.BigEndian.AppendUint32(nil, seq)
+	code := byte(mrand.Int())
 	grpc := make([]byte, len)
 
 	buffer := make([]byte, 0, minPacketLenV1+len)
@@ -50,13 +60,183 @@ func newPacketAEAD(version uint8, session *server.Session, len int) packet {
 	buffer = append(buffer, code)
 	buffer = append(buffer, grpc...)
 
-	nonce := make([]byte, session.Cipher.NonceSize())
-	copy(nonce[:], seqn)
-
-	session.Cipher.Seal(buffer[9:9], nonce, buffer[9:], buffer[0:9])
+	nonce := parseNonce(nil, k.Cipher.NonceSize(), session.Version, seq, nonceRoleClient)
+	k.Cipher.Seal(buffer[9:9], nonce, buffer[9:], buffer[0:9])
 
 	return packet{
 		addr: nil,
 		data: buffer[:cap(buffer)],
 	}
 }
+
+func TestParseNonceLayout(t *testing.T) {
+	const seq uint32 = 0x01020304
+
+	legacy := []byte{1, 2, 3, 4, 0, 0, 0, 0, 0, 0, 0, 0}
+	if got := parseNonce(nil, 12, 1, seq, nonceRoleClient); string(got) != string(legacy) {
+		t.Fatalf("version 1 client nonce changed: %x", got)
+	}
+	if got := parseNonce(nil, 12, 2, seq, nonceRoleClient); string(got) != string(legacy) {
+		t.Fatalf("version 2 client nonce changed: %x", got)
+	}
+
+	prefixed := []byte{'C', 'L', 'N', 'T', 0, 0, 0, 0, 1, 2, 3, 4}
+	if got := parseNonce(nil, 12, 3, seq, nonceRoleClient); string(got) != string(prefixed) {
+		t.Fatalf("unexpected version 3 client nonce: %x", got)
+	}
+
+	for v := uint8(1); v <= 3; v++ {
+		c := parseNonce(nil, 12, v, seq, nonceRoleClient)
+		s := parseNonce(nil, 12, v, seq, nonceRoleServer)
+		if string(c) == string(s) {
+			t.Fatalf("version %d client and server share the nonce %x", v, c)
+		}
+	}
+}
+
+func TestPacketVerifyVersionMismatch(t *testing.T) {
+	priv, _ := ecdh.X25519().GenerateKey(rand.Reader)
+
+	c := server.NewSessionContainer(2)
+	v1 := c.NewSession(server.NewSessionV1, 1)
+	v2 := c.NewSession(server.NewSessionV2(priv.PublicKey()), 2)
+
+	tests := []struct {
+		name    string
+		session *server.Session
+		version uint8
+		valid   bool
+	}{
+		{"V1", v1, 1, true},
+		{"V2", v2, 2, true},
+		{"V1-LabelledV2", v1, 2, false},
+		{"V2-LabelledV1", v2, 1, false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPacketAEADSeq(tt.version, tt.session, tt.session.Key(), uint32(i+1), 20)
+			if (p.verify(c, nil) != nil) != tt.valid {
+				t.Fatalf("expected valid=%v", tt.valid)
+			}
+		})
+	}
+}
+
+func TestPacketVerifyRekeyOverlap(t *testing.T) {
+	defer func(d time.Duration) { rekeyOverlap = d }(rekeyOverlap)
+	rekeyOverlap = 50 * time.Millisecond
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	old := s.Key()
+
+	verify := func(k *server.SessionKey, seq uint32) bool {
+		p := newPacketAEADSeq(1, s, k, seq, 20)
+		return p.verify(c, nil) != nil
+	}
+
+	if !verify(old, 1) {
+		t.Fatal("packet rejected before rekey")
+	}
+	if !verify(old, server.RekeyThreshold) || s.Key() == old || s.Key().Epoch != 1 {
+		t.Fatal("expected a rekey once the threshold is reached")
+	}
+	next := s.Key()
+
+	// A server packet reflected to the server must not verify, even though its
+	// sequence number is fresh for the client direction.
+	reflected := packet{data: sealV1(s, next, RequestCode_Logout, nil)}
+	if reflected.verify(c, nil) != nil {
+		t.Fatal("server packet verified as a client packet")
+	}
+
+	if !verify(next, 1) {
+		t.Fatal("packet rejected with the new key")
+	}
+	if !verify(old, server.RekeyThreshold+1) {
+		t.Fatal("packet rejected with the previous key during the overlap")
+	}
+	if verify(old, server.RekeyThreshold+1) {
+		t.Fatal("replayed packet accepted with the previous key")
+	}
+
+	time.Sleep(2 * rekeyOverlap)
+	if verify(old, server.RekeyThreshold+2) {
+		t.Fatal("packet accepted with the previous key after the overlap")
+	}
+}
+
+func TestPacketVerifyConcurrentLogout(t *testing.T) {
+	const (
+		nbSessions  = 64
+		nbVerifiers = 8
+		nbRounds    = 500
+	)
+
+	c := server.NewSessionContainer(nbSessions)
+	sessions := make([]*server.Session, nbSessions)
+	templates := make([][]byte, nbSessions)
+	for i := range templates {
+		sessions[i] = c.NewSession(server.NewSessionV1, uint(i))
+		templates[i] = newPacketAEAD(1, sessions[i], 20).data
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for v := 0; v < nbVerifiers; v++ {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			var gpb [20]byte
+			for i := v; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				tpl := templates[i%nbSessions]
+				p := packet{data: append([]byte(nil), tpl...)}
+				if h := p.verify(c, gpb[:]); h != nil && h.session.Sidx != p.sidx() {
+					t.Error("verified packet against a foreign session")
+					return
+				}
+			}
+		}(v)
+	}
+
+	for i := 0; i < nbRounds; i++ {
+		j := i % nbSessions
+		if s := c.Remove(sessions[j].Sidx); s != nil {
+			sessions[j] = c.NewSession(server.NewSessionV1, s.Uid)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestPacketVerifyConnected(t *testing.T) {
+	client := newTestSender(t)
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+
+	for seq := uint32(1); seq <= 2; seq++ {
+		p := newPacketAEADSeq(1, s, s.Key(), seq, 20)
+		p.addr = client.LocalAddr().(*net.UDPAddr)
+		if p.verify(c, nil) == nil {
+			t.Fatal("packet rejected")
+		}
+	}
+
+	// Only the first verified packet is acknowledged.
+	_, plain, ok := receive(t, client, s, time.Second)
+	if !ok || plain[0] != ResponseCode_Connected {
+		t.Fatal("first packet not acknowledged with ResponseCode_Connected")
+	}
+	m := &response.Connected{}
+	if err := proto.Unmarshal(plain[1:], m); err != nil || m.Sidx != uint32(s.Sidx) {
+		t.Fatalf("unexpected connected response %v: %v", m, err)
+	}
+	if _, _, ok := receive(t, client, s, 50*time.Millisecond); ok {
+		t.Fatal("second packet acknowledged again")
+	}
+}
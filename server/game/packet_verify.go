@@ -1,9 +1,24 @@
 package game
 
 import (
+	"encoding/binary"
+
+	"github.com/pemmel/gameserver/common"
 	"github.com/pemmel/gameserver/server"
 )
 
+var (
+	replayDuplicateCounter *common.Counter
+	replayStaleCounter     *common.Counter
+	openFailedCounter      *common.Counter
+)
+
+func init() {
+	replayDuplicateCounter = common.RegisterNewCounter("Replay Duplicate Rejected")
+	replayStaleCounter = common.RegisterNewCounter("Replay Stale Rejected")
+	openFailedCounter = common.RegisterNewCounter("Packet Open Failed")
+}
+
 // verify verifies the integrity of a packet using the session information retrieved from the
 // provided session container. It selects the appropriate verification method based on the packet
 // version and delegates the verification process to the corresponding version-specific function.
@@ -28,7 +43,7 @@ func (p *packet) verify(c *server.SessionContainer, gpb []byte) *handleT {
 	}
 
 	switch v {
-	case 1:
+	case 1, 2, 3:
 		return p.verifyV1(session, gpb)
 	default:
 		return nil
@@ -36,8 +51,12 @@ func (p *packet) verify(c *server.SessionContainer, gpb []byte) *handleT {
 }
 
 // verifyV1 verifies the integrity of a packet using the provided session and payload data.
-// It decrypts the payload using the session's cipher, checks for the correct sequence number,
-// and constructs a handleT struct containing the session, address, request code, and payload.
+// It decrypts the payload using the current session key, or the previous session key while
+// its overlap after a rekey has not elapsed, checks that the sequence number has not been
+// seen before within the replay window of that key, records the packet address and time as
+// the session client address and last seen time, and constructs a handleT struct containing
+// the session, address, request code, and payload. The session key is rotated once the
+// client sequence number reaches server.RekeyThreshold.
 // If any error occurs during decryption or validation, it returns nil.
 //
 // Parameters:
@@ -50,14 +69,59 @@ func (p *packet) verify(c *server.SessionContainer, gpb []byte) *handleT {
 //   - *handleT: A pointer to the handleT struct containing session information and decrypted payload,
 //     or nil if decryption or validation fails.
 func (p *packet) verifyV1(s *server.Session, gpb []byte) *handleT {
-	nonceSize := s.Cipher.NonceSize()
-	nonce := parseNonce(gpb, nonceSize, p.sequence())
+	key := s.Key()
+	prev := s.PreviousKey()
 
+	// A failed Open may overwrite the ciphertext, so it is kept aside for the
+	// second attempt, only during the overlap of the previous key.
 	cipher := p.payload()
-	plain, err := s.Cipher.Open(cipher[:0], nonce, cipher, p.header())
-	if err != nil || len(plain) < 1 {
+	var backup []byte
+	if prev != nil {
+		backup = append(backup, cipher...)
+	}
+
+	plain, ok := open(s, key, gpb, p.sequence(), cipher, p.header())
+	if !ok && prev != nil {
+		copy(cipher, backup)
+		key = prev
+		plain, ok = open(s, key, gpb, p.sequence(), cipher, p.header())
+	}
+	if !ok {
+		openFailedCounter.Increment()
 		return nil
 	}
+	if len(plain) < 1 {
+		return nil
+	}
+
+	switch key.Replay.Accept(p.sequence()) {
+	case server.ReplayDuplicate:
+		replayDuplicateCounter.Increment()
+		return nil
+	case server.ReplayStale:
+		replayStaleCounter.Increment()
+		return nil
+	}
+
+	if s.SetRemoteAddr(p.addr) {
+		connected(s)
+	}
+	s.Touch()
+
+	if key == prev {
+		notifyRekey(s, prev)
+	} else {
+		rekey(s, key, p.sequence())
+	}
+
+	if p.records() {
+		return &handleT{
+			session: s,
+			addr:    p.addr,
+			payload: plain,
+			records: true,
+		}
+	}
 
 	return &handleT{
 		session:     s,
@@ -67,24 +131,58 @@ func (p *packet) verifyV1(s *server.Session, gpb []byte) *handleT {
 	}
 }
 
-// fill fills in the buffer with the given value
-func parseNonce(b []byte, nonceSize int, sequence uint32) []byte {
-	if nonceSize < 4 {
-		panic("nonceSize should not be less than 4")
+// open decrypts the ciphertext in place with the provided session key, using
+// the nonce of the client sequence number.
+func open(s *server.Session, k *server.SessionKey, gpb []byte, sequence uint32, cipher, header []byte) ([]byte, bool) {
+	nonce := parseNonce(gpb, k.Cipher.NonceSize(), s.Version, sequence, nonceRoleClient)
+	plain, err := k.Cipher.Open(cipher[:0], nonce, cipher, header)
+	return plain, err == nil
+}
+
+// Nonce role prefixes, so the client and the server never produce the same
+// nonce under the same session key.
+const (
+	nonceRoleClient uint32 = 0x434c4e54 // "CLNT"
+	nonceRoleServer uint32 = 0x53525652 // "SRVR"
+)
+
+// roleNonceVersion is the first session version whose nonces are prefixed with
+// the sender role. Earlier versions keep the nonce layout of their clients.
+const roleNonceVersion uint8 = 3
+
+// legacyNonceRoleServer marks the first padding byte of the server nonces of
+// the session versions preceding roleNonceVersion, where it is always zero for
+// the client.
+const legacyNonceRoleServer byte = 0x80
+
+// parseNonce fills in the buffer with the nonce of the given sequence number.
+// From roleNonceVersion, it is the 4-byte role prefix of the sender, zero padding,
+// then the big-endian sequence number in the last 4 bytes. Before, it is the
+// big-endian sequence number followed by zero padding, whose first byte is set to
+// legacyNonceRoleServer for the server.
+func parseNonce(b []byte, nonceSize int, version uint8, sequence uint32, role uint32) []byte {
+	if nonceSize < 8 {
+		panic("nonceSize should not be less than 8")
 	}
 
 	if len(b) < nonceSize {
 		b = make([]byte, nonceSize)
 	}
 
-	b[0] = byte(sequence >> 24)
-	b[1] = byte(sequence >> 16)
-	b[2] = byte(sequence >> 8)
-	b[3] = byte(sequence)
-
-	for i := 4; i < nonceSize; i++ {
+	for i := 0; i < nonceSize; i++ {
 		b[i] = 0
 	}
 
+	if version < roleNonceVersion {
+		binary.BigEndian.PutUint32(b[0:4], sequence)
+		if role == nonceRoleServer {
+			b[4] = legacyNonceRoleServer
+		}
+		return b[:nonceSize]
+	}
+
+	binary.BigEndian.PutUint32(b[0:4], role)
+	binary.BigEndian.PutUint32(b[nonceSize-4:nonceSize], sequence)
+
 	return b[:nonceSize]
 }
@@ -0,0 +1,366 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
+)
+
+const (
+	recordFlagFragment uint8 = 1 << 0
+
+	recordHeaderLen   = 4 // code, flags, length
+	fragmentHeaderLen = 4 // message id, index, count
+
+	minDatagramSize           = 64
+	defaultMaxDatagramSize    = 1200
+	defaultMaxMessageSize     = 64 << 10
+	defaultMaxReassemblyBytes = 256 << 10
+
+	// maxPartialMessages bounds the messages being reassembled per session.
+	maxPartialMessages = 16
+
+	// reassemblyTimeout is how long the chunks of a partial message are kept.
+	reassemblyTimeout = 5 * time.Second
+
+	// reassemblyIdleTimeout is how long the reassembly of a silent session is kept.
+	reassemblyIdleTimeout = time.Minute
+)
+
+var (
+	maxDatagramSize    = defaultMaxDatagramSize
+	maxMessageSize     = defaultMaxMessageSize
+	maxReassemblyBytes = defaultMaxReassemblyBytes
+
+	// reassemblies holds the reassembly of each session which has sent a record
+	// layout packet by session pointer, which also marks the client of the
+	// session as able to parse record layout packets, see recordEnabled.
+	reassemblies sync.Map
+
+	// fragmentId is the message id of the last fragmented server message.
+	fragmentId atomic.Uint32
+
+	recordMalformedCounter   *common.Counter
+	reassemblyDoneCounter    *common.Counter
+	reassemblyDroppedCounter *common.Counter
+	reassemblyExpiredCounter *common.Counter
+)
+
+func init() {
+	recordMalformedCounter = common.RegisterNewCounter("Record Malformed")
+	reassemblyDoneCounter = common.RegisterNewCounter("Reassembly Done")
+	reassemblyDroppedCounter = common.RegisterNewCounter("Reassembly Dropped")
+	reassemblyExpiredCounter = common.RegisterNewCounter("Reassembly Expired")
+}
+
+// Record represents a response code and its payload, sent along other records
+// in a record layout packet.
+type Record struct {
+	Code    uint8
+	Payload []byte
+}
+
+// reassembly holds the partial messages of a session, bounded in number and in
+// total size to resist abuse.
+type reassembly struct {
+	mutex    sync.Mutex
+	bytes    int
+	partials map[uint16]*partialMessage
+}
+
+type partialMessage struct {
+	code     uint8
+	received int
+	size     int
+	chunks   [][]byte
+	started  time.Time
+}
+
+// maxPlainSize returns the maximum plaintext size of a datagram.
+func maxPlainSize() int {
+	return maxDatagramSize - (minPacketLenV1 - requestCodeLen)
+}
+
+// parseRecords calls f with every record of a record layout payload.
+//
+// Returns:
+//   - bool: False if the payload is malformed, otherwise true.
+func parseRecords(b []byte, f func(code, flags uint8, data []byte)) bool {
+	for len(b) > 0 {
+		if len(b) < recordHeaderLen {
+			return false
+		}
+		n := int(binary.BigEndian.Uint16(b[2:4]))
+		if len(b) < recordHeaderLen+n {
+			return false
+		}
+		f(b[0], b[1], b[recordHeaderLen:recordHeaderLen+n])
+		b = b[recordHeaderLen+n:]
+	}
+	return true
+}
+
+// appendRecord appends a record to a record layout payload.
+func appendRecord(b []byte, code, flags uint8, data ...[]byte) []byte {
+	n := 0
+	for _, d := range data {
+		n += len(d)
+	}
+	b = append(b, code, flags)
+	b = binary.BigEndian.AppendUint16(b, uint16(n))
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	return b
+}
+
+// handleRecords dispatches the records of a record layout packet in order,
+// reassembling the fragmented messages. From then on, the responses to the
+// session may be sent in record layout packets.
+func handleRecords(h *handleT) {
+	sessionReassembly(h.session)
+	ok := parseRecords(h.payload, func(code, flags uint8, data []byte) {
+		if flags&recordFlagFragment != 0 {
+			if data = reassemble(h.session, code, data); data == nil {
+				return
+			}
+		}
+		handle(&handleT{
+			session:     h.session,
+			addr:        h.addr,
+			requestCode: code,
+			payload:     data,
+		})
+	})
+	if !ok {
+		recordMalformedCounter.Increment()
+	}
+}
+
+// reassemble stores a fragment of a client message, returning the message once
+// all its fragments have been received. A message exceeding maxMessageSize, or
+// pushing the session partial messages beyond maxPartialMessages or
+// maxReassemblyBytes, is dropped.
+//
+// Parameters:
+//   - s: The session sending the fragment.
+//   - code: The request code of the message.
+//   - data: The fragment header and chunk.
+//
+// Returns:
+//   - []byte: The reassembled message, or nil if it is not complete yet.
+func reassemble(s *server.Session, code uint8, data []byte) []byte {
+	if len(data) < fragmentHeaderLen {
+		recordMalformedCounter.Increment()
+		return nil
+	}
+	id := binary.BigEndian.Uint16(data[0:2])
+	index, count := int(data[2]), int(data[3])
+	chunk := data[fragmentHeaderLen:]
+	if count == 0 || index >= count {
+		recordMalformedCounter.Increment()
+		return nil
+	}
+
+	r := sessionReassembly(s)
+	now := time.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.partials == nil {
+		r.partials = make(map[uint16]*partialMessage)
+	}
+	r.expire(now)
+
+	m := r.partials[id]
+	if m == nil {
+		if len(r.partials) >= maxPartialMessages {
+			reassemblyDroppedCounter.Increment()
+			return nil
+		}
+		m = &partialMessage{code: code, chunks: make([][]byte, count), started: now}
+		r.partials[id] = m
+	}
+	if m.code != code || len(m.chunks) != count {
+		r.drop(id, m)
+		recordMalformedCounter.Increment()
+		return nil
+	}
+	if m.chunks[index] != nil {
+		return nil
+	}
+	if m.size+len(chunk) > maxMessageSize || r.bytes+len(chunk) > maxReassemblyBytes {
+		r.drop(id, m)
+		reassemblyDroppedCounter.Increment()
+		return nil
+	}
+
+	// The chunk is copied, so that it does not hold the whole datagram buffer.
+	m.chunks[index] = bytes.Clone(chunk)
+	m.size += len(chunk)
+	r.bytes += len(chunk)
+	m.received++
+	if m.received < count {
+		return nil
+	}
+
+	r.drop(id, m)
+	reassemblyDoneCounter.Increment()
+	b := make([]byte, 0, m.size)
+	for _, c := range m.chunks {
+		b = append(b, c...)
+	}
+	return b
+}
+
+// expire drops the partial messages older than reassemblyTimeout. It must be
+// called with the reassembly mutex held.
+func (r *reassembly) expire(now time.Time) {
+	for id, m := range r.partials {
+		if now.Sub(m.started) > reassemblyTimeout {
+			r.drop(id, m)
+			reassemblyExpiredCounter.Increment()
+		}
+	}
+}
+
+// drop removes a partial message. It must be called with the reassembly mutex held.
+func (r *reassembly) drop(id uint16, m *partialMessage) {
+	r.bytes -= m.size
+	delete(r.partials, id)
+}
+
+// sessionReassembly returns the reassembly of the session, creating it if needed.
+func sessionReassembly(s *server.Session) *reassembly {
+	if v, ok := reassemblies.Load(s); ok {
+		return v.(*reassembly)
+	}
+	v, _ := reassemblies.LoadOrStore(s, &reassembly{})
+	return v.(*reassembly)
+}
+
+// recordEnabled reports whether the client of the session parses record layout
+// packets, i.e. it has sent a record layout packet. Clients which never do keep
+// receiving every response in its own packet of the session version.
+func recordEnabled(s *server.Session) bool {
+	_, ok := reassemblies.Load(s)
+	return ok
+}
+
+// releaseReassembly drops the partial messages of a removed session.
+func releaseReassembly(s *server.Session) {
+	reassemblies.Delete(s)
+}
+
+// reassemblySweeper drops the expired partial messages of every session, and the
+// reassembly of sessions silent for reassemblyIdleTimeout, in case a record
+// layout packet was received after the session has been released.
+func reassemblySweeper() {
+	t := time.NewTicker(reassemblyTimeout)
+	for now := range t.C {
+		sweepReassemblies(now)
+	}
+}
+
+func sweepReassemblies(now time.Time) {
+	reassemblies.Range(func(k, v any) bool {
+		s := k.(*server.Session)
+		if now.Sub(s.LastSeen()) > reassemblyIdleTimeout {
+			reassemblies.Delete(s)
+			return true
+		}
+		r := v.(*reassembly)
+		r.mutex.Lock()
+		r.expire(now)
+		r.mutex.Unlock()
+		return true
+	})
+}
+
+// SendBatch seals the records for the provided session into as few record layout
+// packets as possible, fragmenting the records larger than one datagram, and
+// queues them for the last verified address of the session client. The records
+// are sent one by one, see Send, if the session client does not use the record
+// layout, see recordEnabled, or if a single record fits in one datagram.
+//
+// Parameters:
+//   - s: The session to which the records are sent.
+//   - records: The records to send, in order.
+//
+// Returns:
+//   - bool: True if every packet has been queued, otherwise false.
+func (sd *Sender) SendBatch(s *server.Session, records []Record) bool {
+	if s.RemoteAddr() == nil {
+		return false
+	}
+
+	ok := true
+	if !recordEnabled(s) || len(records) == 1 && requestCodeLen+len(records[0].Payload) <= maxPlainSize() {
+		for _, r := range records {
+			ok = sd.send(s, r.Code, r.Payload) && ok
+		}
+		return ok
+	}
+	for _, plain := range packRecords(records, maxPlainSize()) {
+		key := s.Key()
+		b := sealPlainV1(s, key, s.Version|recordLayoutFlag, plain)
+		if b == nil || !sd.enqueue(outbound{s.RemoteAddr(), b}) {
+			ok = false
+		}
+		if seq := key.SequenceSent(); seq >= server.RekeyThreshold {
+			rekey(s, key, seq)
+		}
+	}
+	return ok
+}
+
+// packRecords packs the records into record layout payloads of at most size
+// bytes, fragmenting the records which do not fit in an empty payload. Records
+// larger than maxMessageSize are dropped.
+func packRecords(records []Record, size int) [][]byte {
+	var out [][]byte
+	var cur []byte
+	flush := func() {
+		if len(cur) > 0 {
+			out = append(out, cur)
+			cur = nil
+		}
+	}
+
+	for _, r := range records {
+		n := recordHeaderLen + len(r.Payload)
+		if n <= size {
+			if len(cur)+n > size {
+				flush()
+			}
+			cur = appendRecord(cur, r.Code, 0, r.Payload)
+			continue
+		}
+
+		chunkSize := size - recordHeaderLen - fragmentHeaderLen
+		count := (len(r.Payload) + chunkSize - 1) / chunkSize
+		if len(r.Payload) > maxMessageSize || count > 0xff {
+			reassemblyDroppedCounter.Increment()
+			continue
+		}
+
+		flush()
+		var hdr [fragmentHeaderLen]byte
+		binary.BigEndian.PutUint16(hdr[0:2], uint16(fragmentId.Add(1)))
+		hdr[3] = uint8(count)
+		for i := 0; i < count; i++ {
+			hdr[2] = uint8(i)
+			chunk := r.Payload[i*chunkSize : min((i+1)*chunkSize, len(r.Payload))]
+			cur = appendRecord(nil, r.Code, recordFlagFragment, hdr[:], chunk)
+			flush()
+		}
+	}
+	flush()
+	return out
+}
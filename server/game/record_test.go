@@ -0,0 +1,188 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"google.golang.org/protobuf/proto"
+)
+
+type fragment struct {
+	code, flags uint8
+	data        []byte
+}
+
+func splitRecords(t *testing.T, payloads [][]byte) []fragment {
+	var out []fragment
+	for _, p := range payloads {
+		ok := parseRecords(p, func(code, flags uint8, data []byte) {
+			out = append(out, fragment{code, flags, data})
+		})
+		if !ok {
+			t.Fatal("malformed payload")
+		}
+	}
+	return out
+}
+
+func TestPackRecordsCoalesceAndFragment(t *testing.T) {
+	large := make([]byte, 3000)
+	rand.Read(large)
+	records := []Record{{1, []byte("a")}, {2, []byte("bc")}, {3, large}, {4, nil}}
+
+	payloads := packRecords(records, 500)
+	for _, p := range payloads {
+		if len(p) > 500 {
+			t.Fatalf("payload of %d bytes", len(p))
+		}
+	}
+	// The small records before and after the large one are coalesced.
+	chunks := (len(large) + 491) / 492
+	if len(payloads) != 2+chunks {
+		t.Fatalf("%d payloads, want %d", len(payloads), 2+chunks)
+	}
+
+	frags := splitRecords(t, payloads)
+	if frags[0].code != 1 || frags[1].code != 2 || frags[len(frags)-1].code != 4 {
+		t.Fatal("records out of order")
+	}
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	defer releaseReassembly(s)
+
+	middle := frags[2 : len(frags)-1]
+	rand.Shuffle(len(middle), func(i, j int) { middle[i], middle[j] = middle[j], middle[i] })
+	var got []byte
+	for i, f := range middle {
+		if f.flags&recordFlagFragment == 0 || f.code != 3 {
+			t.Fatal("expected a fragment")
+		}
+		m := reassemble(s, f.code, f.data)
+		if m != nil && i != len(middle)-1 {
+			t.Fatal("message reassembled before its last fragment")
+		}
+		got = m
+		// Duplicated fragments are ignored.
+		if i == 0 && reassemble(s, f.code, f.data) != nil {
+			t.Fatal("duplicate fragment completed the message")
+		}
+	}
+	if !bytes.Equal(got, large) {
+		t.Fatal("reassembled message differs")
+	}
+}
+
+func TestReassemblyLimits(t *testing.T) {
+	defer func(n int) { maxReassemblyBytes = n }(maxReassemblyBytes)
+	maxReassemblyBytes = 1000
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	defer releaseReassembly(s)
+
+	frag := func(id uint16, index, count uint8, n int) []byte {
+		b := binary.BigEndian.AppendUint16(nil, id)
+		return append(b, append([]byte{index, count}, make([]byte, n)...)...)
+	}
+
+	reassemble(s, 1, frag(1, 0, 2, 600))
+	if reassemble(s, 1, frag(2, 0, 2, 600)) != nil {
+		t.Fatal("unexpected message")
+	}
+	v, _ := reassemblies.Load(s)
+	r := v.(*reassembly)
+	if len(r.partials) != 1 || r.bytes != 600 {
+		t.Fatalf("%d partial messages of %d bytes held beyond the cap", len(r.partials), r.bytes)
+	}
+
+	for id := uint16(10); id < 10+2*maxPartialMessages; id++ {
+		reassemble(s, 1, frag(id, 0, 2, 1))
+	}
+	if len(r.partials) != maxPartialMessages {
+		t.Fatalf("%d partial messages, want %d", len(r.partials), maxPartialMessages)
+	}
+
+	if reassemble(s, 2, frag(1, 1, 2, 1)) != nil || r.partials[1] != nil {
+		t.Fatal("fragment with a different code not rejected")
+	}
+}
+
+func TestReassemblyRetention(t *testing.T) {
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	defer releaseReassembly(s)
+
+	// The chunks are copied out of the datagram buffer.
+	buf := []byte{0, 1, 0, 2, 'a', 0xff, 0xff}
+	reassemble(s, 1, buf[:5])
+	buf[4] = 'x'
+	if m := reassemble(s, 1, []byte{0, 1, 1, 2, 'b'}); string(m) != "ab" {
+		t.Fatalf("reassembled %q, want %q", m, "ab")
+	}
+	v, _ := reassemblies.Load(s)
+	r := v.(*reassembly)
+
+	// The expired partial messages are swept, and then the idle reassembly.
+	reassemble(s, 1, []byte{0, 2, 0, 2, 'a'})
+	sweepReassemblies(time.Now().Add(reassemblyTimeout + time.Second))
+	if len(r.partials) != 0 || r.bytes != 0 {
+		t.Fatalf("%d partial messages of %d bytes left after expiry", len(r.partials), r.bytes)
+	}
+	sweepReassemblies(s.LastSeen().Add(reassemblyIdleTimeout + time.Second))
+	if recordEnabled(s) {
+		t.Fatal("reassembly of an idle session not swept")
+	}
+}
+
+func TestVerifyRecordLayout(t *testing.T) {
+	const code uint8 = 252
+	defer func() { routes[code] = nil }()
+
+	var got []uint32
+	Register(code, AnyState, func(r *Request, m *protobuf.RekeyResponse) error {
+		got = append(got, m.Epoch)
+		return nil
+	})
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	defer releaseReassembly(s)
+
+	var plain []byte
+	for epoch := uint32(1); epoch <= 3; epoch++ {
+		p, _ := proto.Marshal(&protobuf.RekeyResponse{Epoch: epoch})
+		plain = appendRecord(plain, code, 0, p)
+	}
+
+	k := s.Key()
+	b := []byte{s.Version | recordLayoutFlag}
+	b = binary.BigEndian.AppendUint32(b, uint32(s.Sidx))
+	b = binary.BigEndian.AppendUint32(b, 1)
+	nonce := parseNonce(nil, k.Cipher.NonceSize(), s.Version, 1, nonceRoleClient)
+	b = k.Cipher.Seal(b, nonce, plain, b)
+
+	p := packet{data: b}
+	if !packetMeaningful(b) {
+		t.Fatal("record layout packet not meaningful")
+	}
+	h := p.verify(c, nil)
+	if h == nil || !h.records {
+		t.Fatal("record layout packet not verified")
+	}
+	if recordEnabled(s) {
+		t.Fatal("record layout enabled before the client used it")
+	}
+	handle(h)
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Fatalf("dispatched %v, want [1 2 3]", got)
+	}
+	if !recordEnabled(s) {
+		t.Fatal("record layout not enabled by a record layout packet")
+	}
+}
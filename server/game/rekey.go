@@ -0,0 +1,78 @@
+package game
+
+import (
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultRekeyOverlap is used when Config.RekeyOverlap is not set.
+const defaultRekeyOverlap = 10 * time.Second
+
+// rekeyNoticeInterval is the minimum interval between two rekey notifications
+// resent to a client still sealing its packets with the previous key.
+const rekeyNoticeInterval = 250 * time.Millisecond
+
+var (
+	// rekeyOverlap is how long the previous session key remains accepted after a rekey.
+	rekeyOverlap = defaultRekeyOverlap
+
+	rekeyCounter       *common.Counter
+	previousKeyCounter *common.Counter
+)
+
+func init() {
+	rekeyCounter = common.RegisterNewCounter("Session Rekey")
+	previousKeyCounter = common.RegisterNewCounter("Previous Key Accepted")
+}
+
+// rekey rotates the session key once either sequence number space of the
+// provided key reaches server.RekeyThreshold, and notifies the client of the
+// new epoch with a packet sealed with the superseded key.
+//
+// Parameters:
+//   - s: The session whose key is rotated.
+//   - k: The key observed as current by the caller.
+//   - seq: The last sequence number used under k, received or sent.
+func rekey(s *server.Session, k *server.SessionKey, seq uint32) {
+	if seq < server.RekeyThreshold {
+		return
+	}
+
+	next := s.Rekey(k, rekeyOverlap)
+	if next == nil {
+		return
+	}
+
+	rekeyCounter.Increment()
+	sendRekey(s, k, next.Epoch)
+}
+
+// notifyRekey resends the rekey notification when a packet has been accepted
+// with the previous key, as the client may have missed the first notification.
+// Notifications are rate limited per session by rekeyNoticeInterval.
+//
+// Parameters:
+//   - s: The session whose client still uses the previous key.
+//   - prev: The previous key of the session.
+func notifyRekey(s *server.Session, prev *server.SessionKey) {
+	previousKeyCounter.Increment()
+
+	if !prev.NotifyDue(rekeyNoticeInterval) {
+		return
+	}
+
+	sendRekey(s, prev, prev.Epoch+1)
+}
+
+// sendRekey sends the rekey notification of the provided epoch sealed with k.
+func sendRekey(s *server.Session, k *server.SessionKey, epoch uint32) {
+	p, err := proto.Marshal(&response.Rekey{Epoch: epoch})
+	if err != nil {
+		return
+	}
+	sendWith(s, k, ResponseCode_Rekey, p)
+}
@@ -0,0 +1,48 @@
+package game
+
+import (
+	"errors"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+// release tears down the reliable channels, partial messages, chat rate limit
+// and the lobby, queue and match membership of a session which has been removed
+// from the session container, so that the remaining players are no longer
+// grouped with a player who is gone.
+//
+// Parameters:
+//   - s: The expired session.
+func release(s *server.Session) {
+	releaseReliable(s)
+	releaseReassembly(s)
+	releaseChat(s)
+
+	s.Mutex.Lock()
+	state := s.GameState
+	s.Mutex.Unlock()
+
+	if state == server.GameState_Match {
+		leaveMatch(s)
+	}
+
+	// A player stays a member of its lobby while queueing and during a match,
+	// so the lobby is left whatever the game state.
+	if errors.Is(lobbyLeave(s.Sidx), ErrNotLobbyMember) {
+		lobbyForget(s.Sidx)
+	}
+}
+
+// resume drops the reliable channels and partial messages of a session which has
+// been replaced by a resumed session, as the client resets them on reconnect, so
+// that its unacknowledged frames are no longer retransmitted with the previous
+// key. The lobby, queue and match membership are kept, as the resumed session
+// has the same session handle.
+//
+// Parameters:
+//   - old: The replaced session.
+//   - s: The resumed session.
+func resume(old, s *server.Session) {
+	releaseReliable(old)
+	releaseReassembly(old)
+}
@@ -0,0 +1,494 @@
+package game
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ReliableChannels is the number of reliable ordered channels per session.
+	ReliableChannels = 4
+
+	// ChannelLobby is the reliable channel of the lobby requests and responses.
+	ChannelLobby uint8 = 0
+
+	// reliableWindow is the number of unacknowledged messages per channel, bounded
+	// by the width of the acknowledgement bitfield.
+	reliableWindow = 64
+
+	// maxReliableBacklog bounds the messages waiting for room in the window.
+	maxReliableBacklog = 256
+
+	reliableTickInterval = 20 * time.Millisecond
+	reliableIdleTimeout  = time.Minute
+	minReliableRto       = 100 * time.Millisecond
+	maxReliableRto       = 2 * time.Second
+)
+
+var (
+	// requestChannels and responseChannels hold the reliable channel plus one of
+	// each code, or 0 for an unreliable code. They are only written before the
+	// game server starts.
+	requestChannels  [256]uint8
+	responseChannels [256]uint8
+
+	// reliables holds the reliable endpoint of each session by session pointer.
+	reliables sync.Map
+
+	reliableSentCounter       *common.Counter
+	reliableRetransmitCounter *common.Counter
+	reliableDuplicateCounter  *common.Counter
+	reliableDroppedCounter    *common.Counter
+)
+
+func init() {
+	reliableSentCounter = common.RegisterNewCounter("Reliable Sent")
+	reliableRetransmitCounter = common.RegisterNewCounter("Reliable Retransmit")
+	reliableDuplicateCounter = common.RegisterNewCounter("Reliable Duplicate")
+	reliableDroppedCounter = common.RegisterNewCounter("Reliable Dropped")
+
+	Register(RequestCode_Reliable, AnyState, receiveReliable)
+
+	for _, code := range []uint8{
+		RequestCode_CreateLobby,
+		RequestCode_InviteLobby,
+		RequestCode_LeaveLobby,
+		RequestCode_AcceptLobbyInvites,
+		RequestCode_RequestJoinLobby,
+		RequestCode_RespondJoinLobby,
+		RequestCode_DismissLobby,
+		RequestCode_SetLobbyMode,
+		RequestCode_SetLobbyHost,
+		RequestCode_SetLobbyReady,
+		RequestCode_KickLobbyPlayer,
+		RequestCode_ListLobbyInvitations,
+		RequestCode_SetLobbyPrivacy,
+		RequestCode_ListJoinRequests,
+		RequestCode_SearchLobbies,
+		RequestCode_LobbyChat,
+	} {
+		ReliableRequest(code, ChannelLobby)
+	}
+	for _, code := range []uint8{
+		ResponseCode_CreateLobby,
+		ResponseCode_JoinLobby,
+		ResponseCode_LobbyUpdate,
+		ResponseCode_LobbyInvitation,
+		ResponseCode_LobbyJoinRequest,
+		ResponseCode_LobbyInvitationUpdate,
+		ResponseCode_LobbyInvitations,
+		ResponseCode_LobbyJoinRequests,
+		ResponseCode_LobbySearch,
+		ResponseCode_LobbyChat,
+		ResponseCode_LobbyChatHistory,
+	} {
+		ReliableResponse(code, ChannelLobby)
+	}
+}
+
+// ReliableRequest accepts the requests of the provided code on the provided
+// reliable channel, in addition to their plain form, and rejects them on any
+// other reliable channel. It must be called before RunGameServer.
+func ReliableRequest(code uint8, channel uint8) {
+	if channel >= ReliableChannels || code == RequestCode_Reliable {
+		panic("game: invalid reliable request")
+	}
+	requestChannels[code] = channel + 1
+}
+
+// ReliableResponse sends the responses of the provided code on the provided
+// reliable channel, to the sessions which use reliable channels, see
+// reliableEnabled. It must be called before RunGameServer.
+func ReliableResponse(code uint8, channel uint8) {
+	if channel >= ReliableChannels || code == ResponseCode_Reliable {
+		panic("game: invalid reliable response")
+	}
+	responseChannels[code] = channel + 1
+}
+
+// reliableEndpoint holds the reliable channels of a session.
+type reliableEndpoint struct {
+	channels [ReliableChannels]reliableChannel
+}
+
+// reliableChannel holds both directions of a reliable ordered channel.
+type reliableChannel struct {
+	mutex sync.Mutex
+
+	// Outbound messages: the last sequence sent, the messages sent and not yet
+	// acknowledged, and the messages waiting for room in the window.
+	sent    uint32
+	pending []*reliableOutbound
+	backlog []*protobuf.ReliableMessage
+
+	// Inbound messages: the highest sequence received and the bitfield of the
+	// sequences received before it, the next sequence to deliver, the messages
+	// received ahead of it, and the in-order messages waiting to be delivered by
+	// the worker currently delivering on the channel, if any.
+	received   uint32
+	bits       uint64
+	expected   uint32
+	buffered   map[uint32]*protobuf.ReliableMessage
+	inbox      []*protobuf.ReliableMessage
+	delivering bool
+}
+
+type reliableOutbound struct {
+	msg     *protobuf.ReliableMessage
+	sent    time.Time
+	rto     time.Duration
+	retries int
+}
+
+// endpoint returns the reliable endpoint of the session, creating it if needed.
+func endpoint(s *server.Session) *reliableEndpoint {
+	if e, ok := reliables.Load(s); ok {
+		return e.(*reliableEndpoint)
+	}
+	e, _ := reliables.LoadOrStore(s, &reliableEndpoint{})
+	return e.(*reliableEndpoint)
+}
+
+// reliableEnabled reports whether the session uses reliable channels, i.e. its
+// client has sent a reliable frame, which may be empty. Clients which never do
+// keep receiving every response in its plain form.
+func reliableEnabled(s *server.Session) bool {
+	_, ok := reliables.Load(s)
+	return ok
+}
+
+// releaseReliable drops the reliable endpoint of a removed session. A resumed
+// session starts with fresh channels, as the client resets them on reconnect.
+func releaseReliable(s *server.Session) {
+	reliables.Delete(s)
+}
+
+// sendReliable queues the response code and payload on a reliable channel of
+// the session, sending it right away if the channel window has room.
+//
+// Returns:
+//   - bool: True if the message has been queued, false if the backlog is full.
+func sendReliable(s *server.Session, channel uint8, code uint8, payload []byte) bool {
+	c := &endpoint(s).channels[channel]
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.queue(code, payload) {
+		return false
+	}
+	c.flush(s, channel, time.Now())
+	return true
+}
+
+// reliableRecords queues the records of the codes sent on a reliable channel of
+// the session on their channel, and replaces them with a single frame per
+// channel, placed where the first of its records was, which transmits the queued
+// messages which fit in the channel window.
+//
+// Parameters:
+//   - s: The session to which the records are sent.
+//   - records: The records to send, in order.
+//
+// Returns:
+//   - []Record: The records to send instead, in order.
+func reliableRecords(s *server.Session, records []Record) []Record {
+	var queued [ReliableChannels][]Record
+	var at [ReliableChannels]int
+	out := make([]Record, 0, len(records))
+	for _, r := range records {
+		c := responseChannels[r.Code]
+		if c == 0 {
+			out = append(out, r)
+			continue
+		}
+		if len(queued[c-1]) == 0 {
+			at[c-1] = len(out)
+			out = append(out, Record{Code: ResponseCode_Reliable})
+		}
+		queued[c-1] = append(queued[c-1], r)
+	}
+
+	e := endpoint(s)
+	now := time.Now()
+	for i, rs := range queued {
+		if len(rs) == 0 {
+			continue
+		}
+		c := &e.channels[i]
+		c.mutex.Lock()
+		for _, r := range rs {
+			c.queue(r.Code, r.Payload)
+		}
+		if msgs := c.advance(s, now); len(msgs) > 0 {
+			out[at[i]].Payload = c.frame(uint8(i), msgs)
+		}
+		c.mutex.Unlock()
+	}
+
+	// Drop the frames left empty, their messages wait for room in the window.
+	n := 0
+	for _, r := range out {
+		if r.Code != ResponseCode_Reliable || r.Payload != nil {
+			out[n] = r
+			n++
+		}
+	}
+	return out[:n]
+}
+
+// queue appends a message to the backlog of the channel.
+// It must be called with the channel mutex held.
+//
+// Returns:
+//   - bool: True if the message has been queued, false if the backlog is full.
+func (c *reliableChannel) queue(code uint8, payload []byte) bool {
+	if len(c.backlog) >= maxReliableBacklog {
+		reliableDroppedCounter.Increment()
+		return false
+	}
+	c.sent++
+	c.backlog = append(c.backlog, &protobuf.ReliableMessage{
+		Sequence: c.sent,
+		Code:     uint32(code),
+		Payload:  payload,
+	})
+	return true
+}
+
+// flush moves the backlog into the window and transmits the moved messages.
+// It must be called with the channel mutex held.
+func (c *reliableChannel) flush(s *server.Session, channel uint8, now time.Time) {
+	if msgs := c.advance(s, now); len(msgs) > 0 {
+		c.transmit(s, channel, msgs)
+	}
+}
+
+// advance moves the backlog into the window, returning the moved messages.
+// It must be called with the channel mutex held.
+func (c *reliableChannel) advance(s *server.Session, now time.Time) []*protobuf.ReliableMessage {
+	n := min(reliableWindow-len(c.pending), len(c.backlog))
+	if n <= 0 {
+		return nil
+	}
+
+	rto := reliableRto(s)
+	msgs := make([]*protobuf.ReliableMessage, n)
+	for i, m := range c.backlog[:n] {
+		c.pending = append(c.pending, &reliableOutbound{msg: m, sent: now, rto: rto})
+		msgs[i] = m
+	}
+	clear(c.backlog[:n])
+	c.backlog = c.backlog[n:]
+
+	reliableSentCounter.Add(uint64(n))
+	return msgs
+}
+
+// transmit sends a frame holding the provided messages and the acknowledgement
+// of the channel. It must be called with the channel mutex held.
+func (c *reliableChannel) transmit(s *server.Session, channel uint8, msgs []*protobuf.ReliableMessage) {
+	if p := c.frame(channel, msgs); p != nil && sender != nil {
+		sender.Send(s, ResponseCode_Reliable, p)
+	}
+}
+
+// frame returns the payload of a frame holding the provided messages and the
+// acknowledgement of the channel, or nil if it can not be marshalled.
+// It must be called with the channel mutex held.
+func (c *reliableChannel) frame(channel uint8, msgs []*protobuf.ReliableMessage) []byte {
+	p, err := proto.Marshal(&response.Reliable{
+		Channel:  uint32(channel),
+		Messages: msgs,
+		Ack:      c.received,
+		AckBits:  c.bits,
+	})
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+// acknowledge removes the pending messages acknowledged by the peer, sampling
+// the session RTT from the messages which were not retransmitted.
+// It must be called with the channel mutex held.
+func (c *reliableChannel) acknowledge(s *server.Session, ack uint32, bits uint64, now time.Time) {
+	pending := c.pending[:0]
+	for _, o := range c.pending {
+		if acked(o.msg.Sequence, ack, bits) {
+			if o.retries == 0 {
+				s.ObserveRTT(now.Sub(o.sent))
+			}
+			continue
+		}
+		pending = append(pending, o)
+	}
+	clear(c.pending[len(pending):])
+	c.pending = pending
+}
+
+// acked reports whether the sequence is acknowledged by ack and its bitfield.
+func acked(seq, ack uint32, bits uint64) bool {
+	d := ack - seq
+	return d == 0 || (d <= 64 && bits&(1<<(d-1)) != 0)
+}
+
+// receive records an inbound message, returning the messages which can now be
+// delivered in order. Duplicates and messages beyond the window are dropped.
+// It must be called with the channel mutex held.
+func (c *reliableChannel) receive(m *protobuf.ReliableMessage) []*protobuf.ReliableMessage {
+	if c.expected == 0 {
+		c.expected = 1
+	}
+
+	seq := m.Sequence
+	ahead := seq - c.expected
+	if int32(ahead) < 0 || c.buffered[seq] != nil {
+		reliableDuplicateCounter.Increment()
+		return nil
+	}
+	if ahead >= reliableWindow {
+		return nil
+	}
+
+	if d := seq - c.received; int32(d) > 0 {
+		if d >= 64 {
+			c.bits = 0
+		} else {
+			c.bits <<= d
+		}
+		if d <= 64 && c.received != 0 {
+			c.bits |= 1 << (d - 1) // the previous highest sequence
+		}
+		c.received = seq
+	} else if d := c.received - seq; d <= 64 {
+		c.bits |= 1 << (d - 1)
+	}
+
+	if c.buffered == nil {
+		c.buffered = make(map[uint32]*protobuf.ReliableMessage)
+	}
+	c.buffered[seq] = m
+
+	var ready []*protobuf.ReliableMessage
+	for {
+		r := c.buffered[c.expected]
+		if r == nil {
+			return ready
+		}
+		delete(c.buffered, c.expected)
+		ready = append(ready, r)
+		c.expected++
+	}
+}
+
+// receiveReliable processes a reliable frame sent by the client: it removes the
+// acknowledged responses, sends back the acknowledgement of the channel and
+// dispatches the new requests in order. Requests are dispatched without the
+// channel mutex held, so handlers can send reliable responses, by a single
+// worker at a time per channel to preserve their order.
+func receiveReliable(r *Request, f *request.Reliable) error {
+	if f.Channel >= ReliableChannels {
+		return ErrInvalidRequest
+	}
+	channel := uint8(f.Channel)
+	c := &endpoint(r.Session).channels[channel]
+	now := time.Now()
+
+	c.mutex.Lock()
+	c.acknowledge(r.Session, f.Ack, f.AckBits, now)
+	c.flush(r.Session, channel, now)
+	if len(f.Messages) == 0 {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	for _, m := range f.Messages {
+		c.inbox = append(c.inbox, c.receive(m)...)
+	}
+	c.transmit(r.Session, channel, nil)
+	if c.delivering {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	c.delivering = true
+	for len(c.inbox) > 0 {
+		inbox := c.inbox
+		c.inbox = nil
+		c.mutex.Unlock()
+
+		for _, d := range inbox {
+			if d.Code > 0xff {
+				continue
+			}
+			handle(&handleT{
+				session:     r.Session,
+				addr:        r.Addr,
+				requestCode: uint8(d.Code),
+				payload:     d.Payload,
+				reliable:    true,
+				channel:     channel,
+			})
+		}
+
+		c.mutex.Lock()
+	}
+	c.delivering = false
+	c.mutex.Unlock()
+	return nil
+}
+
+// reliableRto returns the retransmission timeout of a session message, twice
+// the session RTT bounded by minReliableRto and maxReliableRto.
+func reliableRto(s *server.Session) time.Duration {
+	return min(max(2*s.RTT(), minReliableRto), maxReliableRto)
+}
+
+// retransmitter retransmits the reliable messages not acknowledged within their
+// timeout, doubling the timeout on every retry up to maxReliableRto. Endpoints
+// of sessions silent for reliableIdleTimeout are dropped, in case a frame was
+// received after the session has been released.
+func retransmitter() {
+	t := time.NewTicker(reliableTickInterval)
+	for now := range t.C {
+		retransmit(now)
+	}
+}
+
+func retransmit(now time.Time) {
+	reliables.Range(func(k, v any) bool {
+		s := k.(*server.Session)
+		e := v.(*reliableEndpoint)
+		if now.Sub(s.LastSeen()) > reliableIdleTimeout {
+			reliables.Delete(s)
+			return true
+		}
+		for i := range e.channels {
+			c := &e.channels[i]
+			c.mutex.Lock()
+			var due []*protobuf.ReliableMessage
+			for _, o := range c.pending {
+				if now.Sub(o.sent) < o.rto {
+					continue
+				}
+				o.sent = now
+				o.rto = min(2*o.rto, maxReliableRto)
+				o.retries++
+				due = append(due, o.msg)
+				reliableRetransmitCounter.Increment()
+			}
+			if len(due) > 0 {
+				c.transmit(s, uint8(i), due)
+			}
+			c.mutex.Unlock()
+		}
+		return true
+	})
+}
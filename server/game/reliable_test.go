@@ -0,0 +1,146 @@
+package game
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"google.golang.org/protobuf/proto"
+)
+
+func reliableMessages(code uint8, seqs ...uint32) []*protobuf.ReliableMessage {
+	msgs := make([]*protobuf.ReliableMessage, len(seqs))
+	for i, seq := range seqs {
+		msgs[i] = &protobuf.ReliableMessage{Sequence: seq, Code: uint32(code), Payload: []byte{}}
+	}
+	return msgs
+}
+
+func TestReliableChannelOrdering(t *testing.T) {
+	var c reliableChannel
+	var delivered []uint32
+	for _, m := range reliableMessages(0, 2, 3, 2, 1, 1, 5, 4+reliableWindow) {
+		for _, d := range c.receive(m) {
+			delivered = append(delivered, d.Sequence)
+		}
+	}
+
+	if len(delivered) != 3 || delivered[0] != 1 || delivered[1] != 2 || delivered[2] != 3 {
+		t.Fatalf("delivered %v, want [1 2 3]", delivered)
+	}
+	// 5 is buffered, 4 is missing and 4+reliableWindow is beyond the window.
+	if c.received != 5 || c.bits != 0b1110 || c.expected != 4 {
+		t.Fatalf("ack %d bits %b expected %d", c.received, c.bits, c.expected)
+	}
+}
+
+func TestReliableChannelAcknowledge(t *testing.T) {
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+
+	var ch reliableChannel
+	sent := time.Now().Add(-50 * time.Millisecond)
+	for _, m := range reliableMessages(0, 1, 2, 3, 4, 5) {
+		ch.pending = append(ch.pending, &reliableOutbound{msg: m, sent: sent})
+	}
+	ch.pending[1].retries = 1
+
+	// 5 acknowledged, along with 4, 2 and 1 in the bitfield.
+	ch.acknowledge(s, 5, 0b1101, time.Now())
+	if len(ch.pending) != 1 || ch.pending[0].msg.Sequence != 3 {
+		t.Fatalf("%d pending messages", len(ch.pending))
+	}
+	if rtt := s.RTT(); rtt < 50*time.Millisecond || rtt > time.Second {
+		t.Fatalf("unexpected rtt %v", rtt)
+	}
+}
+
+func TestReliableDispatch(t *testing.T) {
+	const code uint8 = 251
+	defer func() {
+		routes[code] = nil
+		requestChannels[code] = 0
+	}()
+
+	var got []uint32
+	Register(code, AnyState, func(r *Request, m *protobuf.ReliableMessage) error {
+		got = append(got, m.Sequence)
+		return nil
+	})
+	ReliableRequest(code, 1)
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	defer releaseReliable(s)
+
+	if err := dispatch(&handleT{session: s, requestCode: code}); err != nil {
+		t.Fatalf("plain request rejected: %v", err)
+	}
+	if err := dispatch(&handleT{session: s, requestCode: code, reliable: true}); !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("request accepted on the wrong channel: %v", err)
+	}
+	if reliableEnabled(s) {
+		t.Fatal("reliable channels enabled without a reliable frame")
+	}
+	got = nil
+
+	// Each request payload holds its own sequence, to check the delivery order.
+	r := &Request{Session: s}
+	frame := func(seqs ...uint32) *request.Reliable {
+		msgs := reliableMessages(code, seqs...)
+		for _, m := range msgs {
+			m.Payload, _ = proto.Marshal(&protobuf.ReliableMessage{Sequence: m.Sequence})
+		}
+		return &request.Reliable{Channel: 1, Messages: msgs}
+	}
+	for _, f := range []*request.Reliable{frame(2), frame(1, 3), frame(3)} {
+		if err := receiveReliable(r, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("delivered %v, want [1 2 3]", got)
+	}
+	if !reliableEnabled(s) {
+		t.Fatal("reliable channels not enabled by a reliable frame")
+	}
+}
+
+func TestReliableResume(t *testing.T) {
+	c := server.NewSessionContainer(1)
+	c.OnResume(resume)
+	old := c.NewSession(server.NewSessionV1, 1)
+	old.Touch()
+	defer releaseReliable(old)
+
+	for i := 0; i < 3; i++ {
+		if !sendReliable(old, ChannelLobby, ResponseCode_LobbyUpdate, []byte{}) {
+			t.Fatal("failed to queue reliable message")
+		}
+	}
+	if n := len(endpoint(old).channels[ChannelLobby].pending); n != 3 {
+		t.Fatalf("%d unacknowledged messages, want 3", n)
+	}
+	reassemblies.Store(old, &reassembly{})
+
+	s := c.Resume(old, server.NewSessionV1)
+	if s == nil {
+		t.Fatal("failed to resume session")
+	}
+	defer releaseReliable(s)
+	if reliableEnabled(old) {
+		t.Fatal("reliable channels of the replaced session are still kept")
+	}
+	if _, ok := reassemblies.Load(old); ok {
+		t.Fatal("reassembly of the replaced session is still kept")
+	}
+
+	// Nothing is left for the retransmitter to resend with the previous key.
+	retransmit(time.Now().Add(maxReliableRto))
+	if reliableEnabled(old) || reliableEnabled(s) {
+		t.Fatal("retransmitter restored reliable channels")
+	}
+}
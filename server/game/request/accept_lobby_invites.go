@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// AcceptLobbyInvites is the message of RequestCode_AcceptLobbyInvites.
+type AcceptLobbyInvites = protobuf.AcceptLobbyInvitesRequest
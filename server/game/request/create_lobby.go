@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// CreateLobby is the message of RequestCode_CreateLobby.
+type CreateLobby = protobuf.CreateLobbyRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// DismissLobby is the message of RequestCode_DismissLobby.
+type DismissLobby = protobuf.DismissLobbyRequest
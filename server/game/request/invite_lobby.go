@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// InviteLobby is the message of RequestCode_InviteLobby.
+type InviteLobby = protobuf.InviteLobbyRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// KickLobbyPlayer is the message of RequestCode_KickLobbyPlayer.
+type KickLobbyPlayer = protobuf.KickLobbyPlayerRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LeaveLobby is the message of RequestCode_LeaveLobby.
+type LeaveLobby = protobuf.LeaveLobbyRequest
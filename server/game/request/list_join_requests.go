@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// ListJoinRequests is the message of RequestCode_ListJoinRequests.
+type ListJoinRequests = protobuf.ListJoinRequestsRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// ListLobbyInvitations is the message of RequestCode_ListLobbyInvitations.
+type ListLobbyInvitations = protobuf.ListLobbyInvitationsRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyChat is the message of RequestCode_LobbyChat.
+type LobbyChat = protobuf.LobbyChatRequest
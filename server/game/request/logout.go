@@ -1 +1,6 @@
 package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Logout is the message of RequestCode_Logout.
+type Logout = protobuf.LogoutRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Reliable is the message of RequestCode_Reliable.
+type Reliable = protobuf.ReliableFrame
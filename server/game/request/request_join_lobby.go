@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// RequestJoinLobby is the message of RequestCode_RequestJoinLobby.
+type RequestJoinLobby = protobuf.RequestJoinLobbyRequest
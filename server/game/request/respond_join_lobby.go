@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// RespondJoinLobby is the message of RequestCode_RespondJoinLobby.
+type RespondJoinLobby = protobuf.RespondJoinLobbyRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SearchLobbies is the message of RequestCode_SearchLobbies.
+type SearchLobbies = protobuf.SearchLobbiesRequest
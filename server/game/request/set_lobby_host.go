@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SetLobbyHost is the message of RequestCode_SetLobbyHost.
+type SetLobbyHost = protobuf.SetLobbyHostRequest
@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SetLobbyMode is the message of RequestCode_SetLobbyMode.
+type SetLobbyMode = protobuf.SetLobbyModeRequest
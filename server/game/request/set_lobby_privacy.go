@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SetLobbyPrivacy is the message of RequestCode_SetLobbyPrivacy.
+type SetLobbyPrivacy = protobuf.SetLobbyPrivacyRequest
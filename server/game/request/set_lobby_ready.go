@@ -0,0 +1,6 @@
+package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SetLobbyReady is the message of RequestCode_SetLobbyReady.
+type SetLobbyReady = protobuf.SetLobbyReadyRequest
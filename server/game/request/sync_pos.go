@@ -1 +1,6 @@
 package request
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SyncPos is the message of RequestCode_SyncPos.
+type SyncPos = protobuf.SyncPosRequest
@@ -1,10 +1,23 @@
 package game
 
 const (
-	RequestCode_SyncPos            uint8 = 1
-	RequestCode_Logout             uint8 = 2
-	RequestCode_CreateLobby        uint8 = 3
-	RequestCode_InviteLobby        uint8 = 4
-	RequestCode_LeaveLobby         uint8 = 5
-	RequestCode_AcceptLobbyInvites uint8 = 6
+	RequestCode_SyncPos              uint8 = 1
+	RequestCode_Logout               uint8 = 2
+	RequestCode_CreateLobby          uint8 = 3
+	RequestCode_InviteLobby          uint8 = 4
+	RequestCode_LeaveLobby           uint8 = 5
+	RequestCode_AcceptLobbyInvites   uint8 = 6
+	RequestCode_Reliable             uint8 = 7
+	RequestCode_RequestJoinLobby     uint8 = 8
+	RequestCode_RespondJoinLobby     uint8 = 9
+	RequestCode_DismissLobby         uint8 = 10
+	RequestCode_SetLobbyMode         uint8 = 11
+	RequestCode_SetLobbyHost         uint8 = 12
+	RequestCode_SetLobbyReady        uint8 = 13
+	RequestCode_KickLobbyPlayer      uint8 = 14
+	RequestCode_ListLobbyInvitations uint8 = 15
+	RequestCode_SetLobbyPrivacy      uint8 = 16
+	RequestCode_ListJoinRequests     uint8 = 17
+	RequestCode_SearchLobbies        uint8 = 18
+	RequestCode_LobbyChat            uint8 = 19
 )
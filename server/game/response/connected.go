@@ -1 +1,6 @@
 package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Connected is the message of ResponseCode_Connected.
+type Connected = protobuf.ConnectedResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// CreateLobby is the message of ResponseCode_CreateLobby.
+type CreateLobby = protobuf.CreateLobbyResponse
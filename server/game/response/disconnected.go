@@ -1 +1,6 @@
 package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Disconnected is the message of ResponseCode_Disconnected.
+type Disconnected = protobuf.DisconnectedResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Error is the message of ResponseCode_Error.
+type Error = protobuf.ErrorResponse
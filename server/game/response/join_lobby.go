@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// JoinLobby is the message of ResponseCode_JoinLobby.
+type JoinLobby = protobuf.JoinLobbyResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyChat is the message of ResponseCode_LobbyChat.
+type LobbyChat = protobuf.LobbyChatResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyChatHistory is the message of ResponseCode_LobbyChatHistory.
+type LobbyChatHistory = protobuf.LobbyChatHistoryResponse
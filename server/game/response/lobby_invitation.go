@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyInvitation is the message of ResponseCode_LobbyInvitation.
+type LobbyInvitation = protobuf.LobbyInvitationResponse
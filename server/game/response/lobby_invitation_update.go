@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyInvitationUpdate is the message of ResponseCode_LobbyInvitationUpdate.
+type LobbyInvitationUpdate = protobuf.LobbyInvitationUpdateResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyInvitations is the message of ResponseCode_LobbyInvitations.
+type LobbyInvitations = protobuf.LobbyInvitationsResponse
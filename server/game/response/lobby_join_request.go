@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyJoinRequest is the message of ResponseCode_LobbyJoinRequest.
+type LobbyJoinRequest = protobuf.LobbyJoinRequestResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyJoinRequests is the message of ResponseCode_LobbyJoinRequests.
+type LobbyJoinRequests = protobuf.LobbyJoinRequestsResponse
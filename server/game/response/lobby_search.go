@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbySearch is the message of ResponseCode_LobbySearch.
+type LobbySearch = protobuf.LobbySearchResponse
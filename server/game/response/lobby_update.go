@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// LobbyUpdate is the message of ResponseCode_LobbyUpdate.
+type LobbyUpdate = protobuf.LobbyUpdateResponse
@@ -1 +1,6 @@
 package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Reconnecting is the message of ResponseCode_Reconnecting.
+type Reconnecting = protobuf.ReconnectingResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Rekey is the message of ResponseCode_Rekey.
+type Rekey = protobuf.RekeyResponse
@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Reliable is the message of ResponseCode_Reliable.
+type Reliable = protobuf.ReliableFrame
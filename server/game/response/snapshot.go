@@ -0,0 +1,6 @@
+package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// Snapshot is the message of ResponseCode_Snapshot.
+type Snapshot = protobuf.SnapshotResponse
@@ -1 +1,6 @@
 package response
+
+import "github.com/pemmel/gameserver/protobuf"
+
+// SyncPos is the message of ResponseCode_SyncPos.
+type SyncPos = protobuf.SyncPosResponse
@@ -1,10 +1,23 @@
 package game
 
 const (
-	ResponseCode_SyncPos      uint8 = 1
-	ResponseCode_Disconnected uint8 = 2
-	ResponseCode_Connected    uint8 = 3
-	ResponseCode_Reconnecting uint8 = 4
-	ResponseCode_CreateLobby  uint8 = 5
-	ResponseCode_JoinLobby    uint8 = 6
+	ResponseCode_SyncPos               uint8 = 1
+	ResponseCode_Disconnected          uint8 = 2
+	ResponseCode_Connected             uint8 = 3
+	ResponseCode_Reconnecting          uint8 = 4
+	ResponseCode_CreateLobby           uint8 = 5
+	ResponseCode_JoinLobby             uint8 = 6
+	ResponseCode_Rekey                 uint8 = 7
+	ResponseCode_Error                 uint8 = 8
+	ResponseCode_Snapshot              uint8 = 9
+	ResponseCode_Reliable              uint8 = 10
+	ResponseCode_LobbyUpdate           uint8 = 11
+	ResponseCode_LobbyInvitation       uint8 = 12
+	ResponseCode_LobbyJoinRequest      uint8 = 13
+	ResponseCode_LobbyInvitationUpdate uint8 = 14
+	ResponseCode_LobbyInvitations      uint8 = 15
+	ResponseCode_LobbyJoinRequests     uint8 = 16
+	ResponseCode_LobbySearch           uint8 = 17
+	ResponseCode_LobbyChat             uint8 = 18
+	ResponseCode_LobbyChatHistory      uint8 = 19
 )
@@ -0,0 +1,227 @@
+package game
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+const (
+	// rewindHistory is the number of ticks of player positions kept per match.
+	rewindHistory = 64
+
+	defaultMaxRewind = 500 * time.Millisecond
+
+	// hitOriginTolerance is how far a hit origin may be from the rewound
+	// position of the shooter.
+	hitOriginTolerance float32 = 2
+)
+
+var (
+	maxRewind   = defaultMaxRewind
+	interpDelay time.Duration // client interpolation delay, 2 snapshot intervals if zero
+
+	// ErrNoHistory is returned when the match holds no position of a player at
+	// the rewound time.
+	ErrNoHistory = errors.New("game: no position history at the rewound time")
+)
+
+// rewindFrame represents the player positions of a match at a tick.
+type rewindFrame struct {
+	time      time.Time
+	known     [mmTotalPlayerSize]bool
+	positions [mmTotalPlayerSize][3]float32
+}
+
+// HitQuery represents a hit claimed by a player: a ray cast from Origin along
+// Direction which hit Target, whose hitbox is a sphere of Radius around its position.
+type HitQuery struct {
+	Target    server.Handle
+	Origin    [3]float32
+	Direction [3]float32
+	Range     float32
+	Radius    float32
+	Timestamp uint32 // client clock in milliseconds when the hit was fired
+}
+
+// record stores the current player positions as the rewind frame of the tick.
+// It must be called by the tick worker with the match mutex held.
+func (m *liveMatch) record(now time.Time) {
+	f := &m.history[m.tick%rewindHistory]
+	f.time = now
+	for i := range m.states {
+		f.known[i] = !m.states[i].Received.IsZero()
+		f.positions[i] = m.states[i].Position
+	}
+}
+
+// observeClock folds the clock offset between the server and the client of a
+// player, sampled from an accepted state, into the match estimate. The client
+// sampled the state half a round trip before the server received it.
+// It must be called with the match mutex held.
+func (m *liveMatch) observeClock(slot int, st *PlayerState, rtt time.Duration) {
+	sample := st.Received.Add(-rtt/2).UnixMilli() - int64(st.Timestamp)
+	if m.clocks[slot] == 0 {
+		m.clocks[slot] = sample
+		return
+	}
+	m.clocks[slot] += (sample - m.clocks[slot]) / 8
+}
+
+// viewTime returns the server time of the world the player saw at the provided
+// client timestamp: the snapshots it rendered were half a round trip and the
+// interpolation delay old. The time is clamped to the last maxRewind.
+// It must be called with the match mutex held.
+func (m *liveMatch) viewTime(slot int, timestamp uint32, rtt time.Duration, now time.Time) time.Time {
+	delay := interpDelay
+	if delay == 0 {
+		delay = 2 * syncPosInterval
+	}
+
+	t := time.UnixMilli(m.clocks[slot] + int64(timestamp)).Add(-rtt/2 - delay)
+	if oldest := now.Add(-maxRewind); t.Before(oldest) {
+		return oldest
+	}
+	if t.After(now) {
+		return now
+	}
+	return t
+}
+
+// rewind returns the position of a player at the provided time, interpolated
+// between the two recorded frames around it. It must be called with the match
+// mutex held.
+func (m *liveMatch) rewind(slot int, t time.Time) ([3]float32, bool) {
+	var before, after *rewindFrame
+	for i := range m.history {
+		f := &m.history[i]
+		if f.time.IsZero() || !f.known[slot] {
+			continue
+		}
+		if !f.time.After(t) {
+			if before == nil || f.time.After(before.time) {
+				before = f
+			}
+		} else if after == nil || f.time.Before(after.time) {
+			after = f
+		}
+	}
+
+	switch {
+	case before == nil:
+		return [3]float32{}, false
+	case after == nil:
+		return before.positions[slot], true
+	}
+
+	a := float32(t.Sub(before.time)) / float32(after.time.Sub(before.time))
+	p := before.positions[slot]
+	for i := range p {
+		p[i] += (after.positions[slot][i] - p[i]) * a
+	}
+	return p, true
+}
+
+// Rewind returns the position of a match player as the client of the provided
+// session saw it at a client timestamp, compensating for the session RTT.
+//
+// Parameters:
+//   - s: The session of the observing player.
+//   - target: The session handle of the observed player.
+//   - timestamp: The client clock in milliseconds of the observing player.
+//
+// Returns:
+//   - [3]float32: The observed player position.
+//   - error: ErrInvalidState if either player is not in the match of the
+//     session, ErrNoHistory if no position is recorded at that time.
+func Rewind(s *server.Session, target server.Handle, timestamp uint32) ([3]float32, error) {
+	m, i := sessionMatch(s)
+	if m == nil {
+		return [3]float32{}, ErrInvalidState
+	}
+	j := m.slot(target)
+	if j < 0 {
+		return [3]float32{}, ErrInvalidState
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	t := m.viewTime(i, timestamp, s.RTT(), time.Now())
+	p, ok := m.rewind(j, t)
+	if !ok {
+		return [3]float32{}, ErrNoHistory
+	}
+	return p, nil
+}
+
+// ValidateHit evaluates a hit claimed by the player of the provided session
+// against the match as that player saw it: the hit origin must be near the
+// rewound shooter position, and the ray must intersect the rewound target
+// hitbox within range.
+//
+// Parameters:
+//   - s: The session of the shooting player.
+//   - q: The claimed hit.
+//
+// Returns:
+//   - bool: True if the hit is valid, otherwise false.
+//   - error: ErrInvalidState if either player is not in the match of the
+//     session, ErrNoHistory if no position is recorded at that time.
+func ValidateHit(s *server.Session, q HitQuery) (bool, error) {
+	m, i := sessionMatch(s)
+	if m == nil {
+		return false, ErrInvalidState
+	}
+	j := m.slot(q.Target)
+	if j < 0 || j == i {
+		return false, ErrInvalidState
+	}
+
+	m.mutex.Lock()
+	t := m.viewTime(i, q.Timestamp, s.RTT(), time.Now())
+	shooter, ok1 := m.rewind(i, t)
+	target, ok2 := m.rewind(j, t)
+	m.mutex.Unlock()
+	if !ok1 || !ok2 {
+		return false, ErrNoHistory
+	}
+
+	if distance(shooter, q.Origin) > hitOriginTolerance {
+		return false, nil
+	}
+	return rayHitsSphere(q.Origin, q.Direction, q.Range, target, q.Radius), nil
+}
+
+// rayHitsSphere reports whether the ray cast from origin along dir hits the
+// sphere of the provided center and radius within rng.
+func rayHitsSphere(origin, dir [3]float32, rng float32, center [3]float32, radius float32) bool {
+	n := length(dir)
+	if n == 0 || math.IsNaN(float64(n)) {
+		return false
+	}
+
+	var oc [3]float32
+	var proj float32
+	for k := range oc {
+		oc[k] = center[k] - origin[k]
+		proj += oc[k] * dir[k] / n
+	}
+	if proj < 0 {
+		return length(oc) <= radius
+	}
+
+	// Squared distance from the sphere center to the ray.
+	d2 := oc[0]*oc[0] + oc[1]*oc[1] + oc[2]*oc[2] - proj*proj
+	if d2 > radius*radius {
+		return false
+	}
+	entry := proj - float32(math.Sqrt(float64(radius*radius-d2)))
+	return entry <= rng
+}
+
+func distance(a, b [3]float32) float32 {
+	return length([3]float32{a[0] - b[0], a[1] - b[1], a[2] - b[2]})
+}
@@ -0,0 +1,86 @@
+package game
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRayHitsSphere(t *testing.T) {
+	tests := []struct {
+		name   string
+		dir    [3]float32
+		rng    float32
+		center [3]float32
+		hit    bool
+	}{
+		{"Ahead", [3]float32{1, 0, 0}, 100, [3]float32{10, 0.5, 0}, true},
+		{"Miss", [3]float32{1, 0, 0}, 100, [3]float32{10, 2, 0}, false},
+		{"OutOfRange", [3]float32{1, 0, 0}, 5, [3]float32{10, 0, 0}, false},
+		{"Behind", [3]float32{1, 0, 0}, 100, [3]float32{-10, 0, 0}, false},
+		{"Inside", [3]float32{1, 0, 0}, 100, [3]float32{-0.5, 0, 0}, true},
+		{"NoDirection", [3]float32{}, 100, [3]float32{10, 0, 0}, false},
+	}
+	for _, tt := range tests {
+		if got := rayHitsSphere([3]float32{}, tt.dir, tt.rng, tt.center, 1); got != tt.hit {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.hit)
+		}
+	}
+}
+
+func TestRewindHitValidation(t *testing.T) {
+	c, sessions := newMatchSessions(t)
+	m := startMatch(c)
+	defer endMatch(m.config.Id)
+
+	// The target moves one unit along x every 100ms for the last second, while
+	// the shooter stands still above the origin.
+	const shooter, target = 0, 1
+	now := time.Now()
+	m.mutex.Lock()
+	for k := 0; k <= 10; k++ {
+		m.states[shooter] = PlayerState{Position: [3]float32{0, 0, 5}, Received: now}
+		m.states[target] = PlayerState{Position: [3]float32{float32(k), 0, 0}, Received: now}
+		m.record(now.Add(time.Duration(k-10) * 100 * time.Millisecond))
+		m.tick++
+	}
+	// The client clock reads ts 200ms ago. No RTT has been observed, so the
+	// client saw the world one interpolation delay before its timestamp.
+	const ts = 100000
+	m.clocks[shooter] = now.Add(-200*time.Millisecond).UnixMilli() - ts
+	m.mutex.Unlock()
+
+	p, err := Rewind(sessions[shooter], sessions[target].Sidx, ts)
+	if err != nil || math.Abs(float64(p[0]-7)) > 0.1 {
+		t.Fatalf("rewound to %v, want x=7: %v", p, err)
+	}
+
+	p, err = Rewind(sessions[shooter], sessions[target].Sidx, ts-10000)
+	if err != nil || p[0] < 4.5 || p[0] > 5.5 {
+		t.Fatalf("rewind not clamped to the maximum rewind: %v, %v", p, err)
+	}
+
+	q := HitQuery{
+		Target:    sessions[target].Sidx,
+		Origin:    [3]float32{0, 0, 5},
+		Direction: [3]float32{7, 0, -5},
+		Range:     100,
+		Radius:    0.5,
+		Timestamp: ts,
+	}
+	if ok, err := ValidateHit(sessions[shooter], q); !ok || err != nil {
+		t.Fatalf("hit on the rewound target rejected: %v", err)
+	}
+
+	late := q
+	late.Direction = [3]float32{10, 0, -5}
+	if ok, _ := ValidateHit(sessions[shooter], late); ok {
+		t.Fatal("hit on the current target position accepted")
+	}
+
+	moved := q
+	moved.Origin = [3]float32{0, 0, 10}
+	if ok, _ := ValidateHit(sessions[shooter], moved); ok {
+		t.Fatal("hit from away from the shooter accepted")
+	}
+}
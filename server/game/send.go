@@ -0,0 +1,145 @@
+package game
+
+import (
+	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+// sender is the game server Sender, set by RunGameServer.
+var sender *Sender
+
+// Disconnect notifies the client of the provided session that its session has
+// been terminated by the server, e.g. because the same user logged in again.
+// The notification is best-effort and is skipped if the client address is unknown.
+//
+// Parameters:
+//   - s: The terminated session.
+func Disconnect(s *server.Session) {
+	sendMessage(s, ResponseCode_Disconnected, &response.Disconnected{
+		Reason: protobuf.DisconnectReason_DISCONNECT_REASON_KICKED,
+	})
+}
+
+// Expired notifies the client of the provided session that its session has been
+// expired by the session reaper, e.g. because the client went silent for too long.
+// The notification is best-effort and is skipped if the client address is unknown.
+//
+// Parameters:
+//   - s: The expired session.
+func Expired(s *server.Session) {
+	sendMessage(s, ResponseCode_Disconnected, &response.Disconnected{
+		Reason: protobuf.DisconnectReason_DISCONNECT_REASON_EXPIRED,
+	})
+}
+
+// connected acknowledges the first verified packet of the provided session with
+// the server clock.
+func connected(s *server.Session) {
+	sendMessage(s, ResponseCode_Connected, &response.Connected{
+		Sidx:       uint32(s.Sidx),
+		ServerTime: time.Now().UnixMilli(),
+	})
+}
+
+// Reconnect notifies the client of the provided session that its session has
+// been resumed by the auth server with a rotated key, keeping its game state.
+// The notification is best-effort and is skipped if the client address is unknown.
+//
+// Parameters:
+//   - s: The resumed session.
+func Reconnect(s *server.Session) {
+	sendMessage(s, ResponseCode_Reconnecting, &response.Reconnecting{
+		Sidx: uint32(s.Sidx),
+	})
+}
+
+// send queues the response code and payload for the provided session on the game
+// server Sender, see Sender.Send, or on its reliable channel if the response code
+// has one and the session uses reliable channels, see ReliableResponse.
+func send(s *server.Session, code uint8, payload []byte) bool {
+	if sender == nil {
+		return false
+	}
+	if c := responseChannels[code]; c != 0 && reliableEnabled(s) {
+		return sendReliable(s, c-1, code, payload)
+	}
+	return sender.Send(s, code, payload)
+}
+
+// sendMessage marshals the response message and queues it for the provided
+// session on the game server Sender, see Sender.Send.
+func sendMessage(s *server.Session, code uint8, m proto.Message) bool {
+	p, err := proto.Marshal(m)
+	if err != nil {
+		return false
+	}
+	return send(s, code, p)
+}
+
+// sendWith queues the response code and payload sealed with the provided session
+// key on the game server Sender, see Sender.SendWith.
+func sendWith(s *server.Session, k *server.SessionKey, code uint8, payload []byte) bool {
+	if sender == nil {
+		return false
+	}
+	return sender.SendWith(s, k, code, payload)
+}
+
+// sendBatch collects the responses sent to sessions, so that the responses to
+// the same session are sent together in as few datagrams as possible when its
+// client uses the record layout, see sendRecords. A sendBatch is not safe for
+// concurrent use.
+type sendBatch struct {
+	sessions []*server.Session
+	records  map[*server.Session][]Record
+}
+
+// add queues the response code and payload for the provided session.
+func (b *sendBatch) add(s *server.Session, code uint8, payload []byte) {
+	if b.records == nil {
+		b.records = make(map[*server.Session][]Record)
+	}
+	rs, ok := b.records[s]
+	if !ok {
+		b.sessions = append(b.sessions, s)
+	}
+	b.records[s] = append(rs, Record{code, payload})
+}
+
+// addMessage marshals the response message and queues it for the provided session.
+func (b *sendBatch) addMessage(s *server.Session, code uint8, m proto.Message) {
+	p, err := proto.Marshal(m)
+	if err != nil {
+		return
+	}
+	b.add(s, code, p)
+}
+
+// flush sends the queued responses of every session, in the order they were
+// queued, and empties the batch.
+func (b *sendBatch) flush() {
+	for i, s := range b.sessions {
+		sendRecords(s, b.records[s])
+		b.sessions[i] = nil
+	}
+	b.sessions = b.sessions[:0]
+	clear(b.records)
+}
+
+// sendRecords sends the records to the provided session like send, packed into
+// as few datagrams as possible when its client uses the record layout, see
+// Sender.SendBatch. The records sent on a reliable channel are carried by a
+// single reliable frame per channel, see reliableRecords.
+func sendRecords(s *server.Session, records []Record) bool {
+	if sender == nil {
+		return false
+	}
+	if reliableEnabled(s) {
+		records = reliableRecords(s, records)
+	}
+	return sender.SendBatch(s, records)
+}
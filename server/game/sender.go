@@ -0,0 +1,247 @@
+package game
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/server"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	defaultSendQueueCapacity = 1 << 16
+	defaultSendBatchSize     = 64
+)
+
+var (
+	sendQueuedCounter  *common.Counter
+	sendBlockedCounter *common.Counter
+	sendDroppedCounter *common.Counter
+	sendErrorCounter   *common.Counter
+)
+
+func init() {
+	sendQueuedCounter = common.RegisterNewCounter("Send Queued")
+	sendBlockedCounter = common.RegisterNewCounter("Send Blocked")
+	sendDroppedCounter = common.RegisterNewCounter("Send Dropped")
+	sendErrorCounter = common.RegisterNewCounter("Send Error")
+}
+
+// outbound represents a sealed packet waiting to be written by the Sender.
+type outbound struct {
+	addr *net.UDPAddr
+	data []byte
+}
+
+// Sender writes server packets to game clients over the game server UDP socket.
+// Packets are sealed by the calling goroutine, so the sealing cost is spread across
+// workers, and queued on a bounded queue drained by a single writer goroutine which
+// writes them in batches.
+type Sender struct {
+	conn         *net.UDPConn
+	queue        chan outbound
+	batchSize    int
+	blockTimeout time.Duration
+}
+
+// NewSender creates a Sender writing to the provided UDP connection and spawns
+// its writer goroutine.
+//
+// Parameters:
+//   - conn: The game server UDP connection.
+//   - capacity: The capacity of the send queue.
+//   - batchSize: The maximum number of packets written at once.
+//   - blockTimeout: How long to wait for room when the send queue is full before
+//     dropping the packet. Packets are dropped immediately if zero.
+//
+// Returns:
+//   - *Sender: The sender.
+func NewSender(conn *net.UDPConn, capacity, batchSize int, blockTimeout time.Duration) *Sender {
+	if capacity <= 0 {
+		capacity = defaultSendQueueCapacity
+	}
+	if batchSize <= 0 {
+		batchSize = defaultSendBatchSize
+	}
+
+	s := &Sender{
+		conn:         conn,
+		queue:        make(chan outbound, capacity),
+		batchSize:    batchSize,
+		blockTimeout: blockTimeout,
+	}
+	go s.writer()
+	return s
+}
+
+// Send seals the response code and payload with the current key of the provided
+// session and queues it for the last verified address of the session client.
+// A payload too large for one datagram is fragmented if the session client uses
+// the record layout, see SendBatch.
+// The session key is rotated once the server sequence number reaches
+// server.RekeyThreshold.
+//
+// Parameters:
+//   - s: The session to which the packet is sent.
+//   - code: The response code.
+//   - payload: The protobuf data following the response code.
+//
+// Returns:
+//   - bool: True if the packet has been queued, otherwise false.
+func (sd *Sender) Send(s *server.Session, code uint8, payload []byte) bool {
+	if requestCodeLen+len(payload) > maxPlainSize() && recordEnabled(s) {
+		return sd.SendBatch(s, []Record{{code, payload}})
+	}
+	return sd.send(s, code, payload)
+}
+
+// send seals the response code and payload in a packet of the session version,
+// see Send.
+func (sd *Sender) send(s *server.Session, code uint8, payload []byte) bool {
+	key := s.Key()
+	ok := sd.SendWith(s, key, code, payload)
+	if seq := key.SequenceSent(); seq >= server.RekeyThreshold {
+		rekey(s, key, seq)
+	}
+	return ok
+}
+
+// SendWith seals the response code and payload with the provided session key and
+// queues it for the last verified address of the session client.
+//
+// Parameters:
+//   - s: The session to which the packet is sent.
+//   - k: The session key used to seal the packet.
+//   - code: The response code.
+//   - payload: The protobuf data following the response code.
+//
+// Returns:
+//   - bool: True if the packet has been queued, otherwise false.
+func (sd *Sender) SendWith(s *server.Session, k *server.SessionKey, code uint8, payload []byte) bool {
+	addr := s.RemoteAddr()
+	if addr == nil {
+		return false
+	}
+
+	b := sealV1(s, k, code, payload)
+	if b == nil {
+		return false
+	}
+
+	return sd.enqueue(outbound{addr, b})
+}
+
+// enqueue queues the packet, waiting up to the block timeout if the queue is full.
+func (sd *Sender) enqueue(o outbound) bool {
+	select {
+	case sd.queue <- o:
+		sendQueuedCounter.Increment()
+		return true
+	default:
+	}
+
+	if sd.blockTimeout <= 0 {
+		sendDroppedCounter.Increment()
+		return false
+	}
+
+	sendBlockedCounter.Increment()
+	t := time.NewTimer(sd.blockTimeout)
+	defer t.Stop()
+
+	select {
+	case sd.queue <- o:
+		sendQueuedCounter.Increment()
+		return true
+	case <-t.C:
+		sendDroppedCounter.Increment()
+		return false
+	}
+}
+
+// writer drains the send queue, collecting the packets already queued into a
+// batch of up to batchSize packets written with a single call when supported.
+func (sd *Sender) writer() {
+	pc := ipv4.NewPacketConn(sd.conn)
+	msgs := make([]ipv4.Message, sd.batchSize)
+	bufs := make([][]byte, sd.batchSize)
+	for i := range msgs {
+		msgs[i].Buffers = bufs[i : i+1]
+	}
+
+	for o := range sd.queue {
+		bufs[0], msgs[0].Addr = o.data, o.addr
+		n := 1
+
+	batch:
+		for n < len(msgs) {
+			select {
+			case o = <-sd.queue:
+				bufs[n], msgs[n].Addr = o.data, o.addr
+				n++
+			default:
+				break batch
+			}
+		}
+
+		sd.write(pc, msgs[:n])
+		for i := 0; i < n; i++ {
+			bufs[i] = nil
+			msgs[i].Addr = nil
+		}
+	}
+}
+
+// write writes the batch, skipping a packet each time the write fails so a
+// single unreachable client does not discard the rest of the batch.
+func (sd *Sender) write(pc *ipv4.PacketConn, msgs []ipv4.Message) {
+	for len(msgs) > 0 {
+		n, err := pc.WriteBatch(msgs, 0)
+		if err != nil {
+			sendErrorCounter.Increment()
+			n++
+		}
+		msgs = msgs[n:]
+	}
+}
+
+// sealV1 builds a packet in the same format as the client request packet, sealed
+// with the provided session key under its next server sequence number.
+//
+// Parameters:
+//   - s: The session to which the packet is sent.
+//   - k: The session key whose cipher and sequence number are used.
+//   - code: The response code.
+//   - payload: The protobuf data following the response code.
+//
+// Returns:
+//   - []byte: The sealed packet, or nil if the key sequence numbers are exhausted.
+func sealV1(s *server.Session, k *server.SessionKey, code uint8, payload []byte) []byte {
+	plain := make([]byte, 0, requestCodeLen+len(payload))
+	plain = append(plain, code)
+	plain = append(plain, payload...)
+	return sealPlainV1(s, k, s.Version, plain)
+}
+
+// sealPlainV1 seals the plaintext with the provided session key under its next
+// server sequence number, behind a header with the provided version byte.
+//
+// Returns:
+//   - []byte: The sealed packet, or nil if the key sequence numbers are exhausted.
+func sealPlainV1(s *server.Session, k *server.SessionKey, version uint8, plain []byte) []byte {
+	seq, ok := k.NextSequence()
+	if !ok {
+		return nil
+	}
+
+	b := make([]byte, 0, minPacketLenV1-requestCodeLen+len(plain))
+	b = append(b, version)
+	b = binary.BigEndian.AppendUint32(b, uint32(s.Sidx))
+	b = binary.BigEndian.AppendUint32(b, seq)
+
+	var gpb [16]byte
+	nonce := parseNonce(gpb[:], k.Cipher.NonceSize(), s.Version, seq, nonceRoleServer)
+	return k.Cipher.Seal(b, nonce, plain, b[:payloadBeginPos])
+}
@@ -0,0 +1,227 @@
+package game
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSenderSealsForClient(t *testing.T) {
+	const nbPackets = 200
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	sd := NewSender(conn, nbPackets, 16, time.Second)
+	if sd.Send(s, ResponseCode_Connected, nil) {
+		t.Fatal("packet queued without a known client address")
+	}
+
+	s.SetRemoteAddr(client.LocalAddr().(*net.UDPAddr))
+	for i := 0; i < nbPackets; i++ {
+		if !sd.Send(s, ResponseCode_SyncPos, []byte{byte(i)}) {
+			t.Fatal("packet not queued")
+		}
+	}
+
+	k := s.Key()
+	seen := make(map[uint32]bool)
+	b := make([]byte, 1500)
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for len(seen) < nbPackets {
+		n, _, err := client.ReadFromUDP(b)
+		if err != nil {
+			t.Fatalf("received %d packets: %v", len(seen), err)
+		}
+		p := b[:n]
+		if binary.BigEndian.Uint32(p[1:5]) != uint32(s.Sidx) {
+			t.Fatal("unexpected sidx")
+		}
+		seq := binary.BigEndian.Uint32(p[5:9])
+		nonce := parseNonce(nil, k.Cipher.NonceSize(), s.Version, seq, nonceRoleServer)
+		plain, err := k.Cipher.Open(nil, nonce, p[9:], p[:9])
+		if err != nil || plain[0] != ResponseCode_SyncPos || seen[seq] {
+			t.Fatalf("invalid packet with sequence %d", seq)
+		}
+		seen[seq] = true
+	}
+}
+
+func TestSenderDropsWhenFull(t *testing.T) {
+	// No writer goroutine drains this queue.
+	sd := &Sender{queue: make(chan outbound, 1), blockTimeout: 10 * time.Millisecond}
+
+	if !sd.enqueue(outbound{}) {
+		t.Fatal("packet not queued")
+	}
+	if sd.enqueue(outbound{}) {
+		t.Fatal("packet queued beyond the queue capacity")
+	}
+}
+
+// newTestSender sets the game server Sender to a Sender writing from a local
+// socket, restored when the test ends, and returns the socket of a client.
+func newTestSender(t *testing.T) *net.UDPConn {
+	t.Helper()
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := sender
+	sender = NewSender(conn, 64, 16, time.Second)
+	t.Cleanup(func() {
+		sender = prev
+		conn.Close()
+		client.Close()
+	})
+	return client
+}
+
+// receive reads the next packet sent to the client of the session, returning
+// its version byte and its plaintext, or false if none arrives before timeout.
+func receive(t *testing.T, client *net.UDPConn, s *server.Session, timeout time.Duration) (uint8, []byte, bool) {
+	t.Helper()
+	b := make([]byte, 1500)
+	client.SetReadDeadline(time.Now().Add(timeout))
+	n, _, err := client.ReadFromUDP(b)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return 0, nil, false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := b[:n]
+	k := s.Key()
+	nonce := parseNonce(nil, k.Cipher.NonceSize(), s.Version, binary.BigEndian.Uint32(p[5:9]), nonceRoleServer)
+	plain, err := k.Cipher.Open(nil, nonce, p[9:], p[:9])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p[0], plain, true
+}
+
+func TestSendBatchCoalesces(t *testing.T) {
+	client := newTestSender(t)
+	c := server.NewSessionContainer(2)
+	codes := []uint8{ResponseCode_SyncPos, ResponseCode_Snapshot, ResponseCode_Error}
+
+	t.Run("Records", func(t *testing.T) {
+		s := c.NewSession(server.NewSessionV1, 1)
+		s.SetRemoteAddr(client.LocalAddr().(*net.UDPAddr))
+		sessionReassembly(s) // the client has sent a record layout packet
+		defer releaseReassembly(s)
+
+		var b sendBatch
+		for i, code := range codes {
+			b.add(s, code, []byte{byte(i)})
+		}
+		b.flush()
+
+		v, plain, ok := receive(t, client, s, 5*time.Second)
+		if !ok || v != s.Version|recordLayoutFlag {
+			t.Fatalf("expected a record layout packet, got version %#x", v)
+		}
+		var got []uint8
+		parseRecords(plain, func(code, flags uint8, data []byte) {
+			if len(data) != 1 || int(data[0]) != len(got) {
+				t.Fatalf("unexpected record %d payload %v", code, data)
+			}
+			got = append(got, code)
+		})
+		if string(got) != string(codes) {
+			t.Fatalf("records %v, want %v", got, codes)
+		}
+		if _, _, ok := receive(t, client, s, 100*time.Millisecond); ok {
+			t.Fatal("responses sent in more than one datagram")
+		}
+	})
+
+	// A client which has never sent a record layout packet receives every
+	// response in its own packet, including the responses too large for one
+	// datagram.
+	t.Run("Plain", func(t *testing.T) {
+		s := c.NewSession(server.NewSessionV1, 2)
+		s.SetRemoteAddr(client.LocalAddr().(*net.UDPAddr))
+
+		var b sendBatch
+		for _, code := range codes {
+			b.add(s, code, nil)
+		}
+		b.flush()
+		for _, code := range codes {
+			v, plain, ok := receive(t, client, s, 5*time.Second)
+			if !ok || v != s.Version || plain[0] != code {
+				t.Fatalf("expected a plain %d response, got version %#x", code, v)
+			}
+		}
+
+		if !sender.Send(s, ResponseCode_Snapshot, make([]byte, maxPlainSize())) {
+			t.Fatal("large response not queued")
+		}
+		if v, _, ok := receive(t, client, s, 5*time.Second); !ok || v != s.Version {
+			t.Fatalf("expected a plain large response, got version %#x", v)
+		}
+	})
+}
+
+func TestSendBatchReliable(t *testing.T) {
+	client := newTestSender(t)
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV1, 1)
+	s.SetRemoteAddr(client.LocalAddr().(*net.UDPAddr))
+	sessionReassembly(s)
+	defer releaseReassembly(s)
+	endpoint(s) // the client has sent a reliable frame
+	defer releaseReliable(s)
+
+	var b sendBatch
+	b.add(s, ResponseCode_LobbyUpdate, []byte{1})
+	b.add(s, ResponseCode_SyncPos, []byte{2})
+	b.add(s, ResponseCode_LobbyChat, []byte{3})
+	b.flush()
+
+	// The lobby responses share a single reliable frame, sent along the
+	// unreliable response.
+	_, plain, ok := receive(t, client, s, 5*time.Second)
+	if !ok {
+		t.Fatal("no packet received")
+	}
+	var got []uint8
+	var frame response.Reliable
+	parseRecords(plain, func(code, flags uint8, data []byte) {
+		got = append(got, code)
+		if code == ResponseCode_Reliable {
+			if err := proto.Unmarshal(data, &frame); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	if len(got) != 2 || got[0] != ResponseCode_Reliable || got[1] != ResponseCode_SyncPos {
+		t.Fatalf("records %v, want a reliable frame then the sync", got)
+	}
+	if len(frame.Messages) != 2 || frame.Messages[0].Code != uint32(ResponseCode_LobbyUpdate) ||
+		frame.Messages[1].Code != uint32(ResponseCode_LobbyChat) {
+		t.Fatalf("unexpected reliable messages %v", frame.Messages)
+	}
+}
@@ -0,0 +1,31 @@
+package game
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+// testUidBase keeps the user IDs of the test sessions clear of the literal user
+// IDs used by the benchmarks.
+const testUidBase uint = 1 << 20
+
+var testUids atomic.Uint64
+
+// newTestSessions creates n version 1 sessions with unique user IDs in the shared
+// session container, which are removed once the test completes.
+func newTestSessions(t testing.TB, n int) []*server.Session {
+	t.Helper()
+	sessions := make([]*server.Session, n)
+	for i := range sessions {
+		uid := testUidBase + uint(testUids.Add(1))
+		s := server.SharedSession().NewSession(server.NewSessionV1, uid)
+		if s == nil {
+			t.Fatal("failed to create session")
+		}
+		sessions[i] = s
+		t.Cleanup(func() { server.SharedSession().Remove(s.Sidx) })
+	}
+	return sessions
+}
@@ -0,0 +1,153 @@
+package game
+
+import (
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/response"
+	"google.golang.org/protobuf/proto"
+)
+
+// snapshotHistory is the number of snapshots kept per match as delta baselines.
+// A client whose acknowledged snapshot is older receives a full snapshot.
+const snapshotHistory = 32
+
+var (
+	snapshotFullCounter  *common.Counter
+	snapshotDeltaCounter *common.Counter
+)
+
+func init() {
+	snapshotFullCounter = common.RegisterNewCounter("Snapshot Full")
+	snapshotDeltaCounter = common.RegisterNewCounter("Snapshot Delta")
+}
+
+// matchSnapshot represents the player states of a match emitted under a sequence.
+type matchSnapshot struct {
+	sequence uint32
+	sent     time.Time
+	states   [mmTotalPlayerSize]PlayerState
+}
+
+// emitSnapshot records the player states as the next match snapshot and sends
+// it to every player, delta encoded against the latest snapshot the player
+// acknowledged, or full if that snapshot is no longer in the history. The
+// snapshots are queued on the match outbox, sent at the end of the tick.
+// It must only be called by the tick worker running the match.
+//
+// Parameters:
+//   - now: The time of the tick.
+//   - states: The player states, indexed like MatchConfig.PlayerConfigs.
+//   - acks: The latest snapshot sequence acknowledged by each player.
+func (m *liveMatch) emitSnapshot(now time.Time, states *[mmTotalPlayerSize]PlayerState, acks *[mmTotalPlayerSize]uint32) {
+	m.sequence++
+	if m.sequence == 0 {
+		m.sequence++ // 0 acknowledges no snapshot
+	}
+	cur := &m.snapshots[m.sequence%snapshotHistory]
+	cur.sequence = m.sequence
+	cur.sent = now
+	cur.states = *states
+
+	for i, pc := range m.config.PlayerConfigs {
+		s := server.SharedSession().Get(pc.Sidx)
+		if s == nil {
+			continue
+		}
+
+		base := m.baseline(acks[i])
+		r := encodeSnapshot(&m.config, base, cur, i)
+		r.ServerTime = now.UnixMilli()
+		if base == nil {
+			snapshotFullCounter.Increment()
+		} else {
+			snapshotDeltaCounter.Increment()
+		}
+
+		p, err := proto.Marshal(r)
+		if err != nil {
+			continue
+		}
+		m.outbox.add(s, ResponseCode_Snapshot, p)
+	}
+}
+
+// baseline returns the snapshot with the provided sequence if it is still in
+// the history, otherwise nil.
+func (m *liveMatch) baseline(ack uint32) *matchSnapshot {
+	if ack == 0 || int32(m.sequence-ack) <= 0 || m.sequence-ack >= snapshotHistory {
+		return nil
+	}
+	b := &m.snapshots[ack%snapshotHistory]
+	if b.sequence != ack {
+		return nil
+	}
+	return b
+}
+
+// encodeSnapshot encodes the snapshot cur for the player at index self, listing
+// the other players which changed since base, or every known player if base is nil.
+//
+// Parameters:
+//   - c: The match configuration holding the players.
+//   - base: The baseline snapshot, or nil for a full snapshot.
+//   - cur: The snapshot to encode.
+//   - self: The index of the receiving player, whose own state is omitted.
+//
+// Returns:
+//   - *response.Snapshot: The encoded snapshot.
+func encodeSnapshot(c *MatchConfig, base, cur *matchSnapshot, self int) *response.Snapshot {
+	r := &response.Snapshot{Sequence: cur.sequence}
+	if base != nil {
+		r.Baseline = base.sequence
+	}
+
+	for i := range cur.states {
+		if i == self {
+			continue
+		}
+
+		st := &cur.states[i]
+		var prev *PlayerState
+		if base != nil && !base.states[i].Received.IsZero() {
+			prev = &base.states[i]
+		}
+		if d := playerDelta(c.PlayerConfigs[i].Sidx, prev, st); d != nil {
+			r.Players = append(r.Players, d)
+		}
+	}
+	return r
+}
+
+// playerDelta returns the delta of a player state against its baseline state,
+// or nil if the state is unchanged.
+//
+// Parameters:
+//   - sidx: The session handle of the player.
+//   - prev: The baseline state, or nil if the player had no baseline state.
+//   - st: The current state.
+func playerDelta(sidx server.Handle, prev, st *PlayerState) *protobuf.PlayerDelta {
+	if st.Received.IsZero() {
+		if prev == nil {
+			return nil
+		}
+		return &protobuf.PlayerDelta{Sidx: uint32(sidx), Removed: true}
+	}
+	if prev != nil && prev.Timestamp == st.Timestamp {
+		return nil
+	}
+
+	d := &protobuf.PlayerDelta{Sidx: uint32(sidx), Timestamp: uint32(st.Received.UnixMilli())}
+	if prev == nil || prev.Position != st.Position {
+		d.Position = &protobuf.Vector3{X: st.Position[0], Y: st.Position[1], Z: st.Position[2]}
+	}
+	if prev == nil || prev.Rotation != st.Rotation {
+		d.Rotation = &protobuf.Quaternion{X: st.Rotation[0], Y: st.Rotation[1], Z: st.Rotation[2], W: st.Rotation[3]}
+	}
+	if prev == nil || prev.Velocity != st.Velocity {
+		d.Velocity = &protobuf.Vector3{X: st.Velocity[0], Y: st.Velocity[1], Z: st.Velocity[2]}
+	}
+	return d
+}
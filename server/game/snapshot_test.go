@@ -0,0 +1,74 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshotDelta(t *testing.T) {
+	c, _ := newMatchSessions(t)
+	m := startMatch(c)
+	defer endMatch(m.config.Id)
+
+	var states [mmTotalPlayerSize]PlayerState
+	var acks [mmTotalPlayerSize]uint32
+	now := time.Now()
+	for i := range states {
+		states[i] = PlayerState{Position: [3]float32{float32(i)}, Timestamp: 1, Received: now}
+	}
+	m.emitSnapshot(now, &states, &acks)
+	first := m.sequence
+
+	// Player 1 moves, player 2 leaves, player 3 only turns.
+	states[1].Position[0], states[1].Timestamp = 10, 2
+	states[2] = PlayerState{}
+	states[3].Rotation[3], states[3].Timestamp = 1, 2
+	m.emitSnapshot(now, &states, &acks)
+
+	full := encodeSnapshot(&m.config, m.baseline(0), &m.snapshots[m.sequence%snapshotHistory], 0)
+	if full.Baseline != 0 || len(full.Players) != mmTotalPlayerSize-2 {
+		t.Fatalf("full snapshot: baseline %d, %d players", full.Baseline, len(full.Players))
+	}
+
+	delta := encodeSnapshot(&m.config, m.baseline(first), &m.snapshots[m.sequence%snapshotHistory], 0)
+	if delta.Baseline != first || len(delta.Players) != 3 {
+		t.Fatalf("delta snapshot: baseline %d, %d players", delta.Baseline, len(delta.Players))
+	}
+	moved, left, turned := delta.Players[0], delta.Players[1], delta.Players[2]
+	if moved.Position.GetX() != 10 || moved.Rotation != nil || moved.Velocity != nil {
+		t.Errorf("moved player delta: %v", moved)
+	}
+	if !left.Removed {
+		t.Errorf("left player delta: %v", left)
+	}
+	if turned.Position != nil || turned.Rotation.GetW() != 1 {
+		t.Errorf("turned player delta: %v", turned)
+	}
+}
+
+func TestSnapshotBaselineFallback(t *testing.T) {
+	c, _ := newMatchSessions(t)
+	m := startMatch(c)
+	defer endMatch(m.config.Id)
+
+	var states [mmTotalPlayerSize]PlayerState
+	var acks [mmTotalPlayerSize]uint32
+	for i := 0; i < snapshotHistory+2; i++ {
+		m.emitSnapshot(time.Now(), &states, &acks)
+	}
+
+	tests := map[string]uint32{
+		"None":   0,
+		"Future": m.sequence + 1,
+		"Latest": m.sequence,
+		"Old":    m.sequence - snapshotHistory,
+	}
+	for name, ack := range tests {
+		if m.baseline(ack) != nil {
+			t.Errorf("%s: expected full snapshot for ack %d", name, ack)
+		}
+	}
+	if b := m.baseline(m.sequence - 1); b == nil || b.sequence != m.sequence-1 {
+		t.Error("expected the previous snapshot as baseline")
+	}
+}
@@ -0,0 +1,163 @@
+package game
+
+import (
+	"math"
+	"time"
+
+	"github.com/pemmel/gameserver/common"
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+	"github.com/pemmel/gameserver/server/game/response"
+)
+
+const (
+	defaultSyncPosRate         = 20
+	defaultMaxSpeed    float32 = 10
+	defaultMaxTeleport float32 = 5
+
+	// speedTolerance and positionSlack absorb the sampling and rounding error
+	// of the client movement before a position update is rejected.
+	speedTolerance float32 = 1.2
+	positionSlack  float32 = 0.5
+
+	// syncPosJitter bounds how much longer than the server observed interval the
+	// client may claim to have moved for, as the client clock is not trusted.
+	syncPosJitter = 250 * time.Millisecond
+)
+
+var (
+	syncPosInterval = time.Second / defaultSyncPosRate
+	maxSpeed        = defaultMaxSpeed
+	maxTeleport     = defaultMaxTeleport
+
+	syncPosAcceptedCounter *common.Counter
+	syncPosRejectedCounter *common.Counter
+	syncPosStaleCounter    *common.Counter
+)
+
+func init() {
+	syncPosAcceptedCounter = common.RegisterNewCounter("SyncPos Accepted")
+	syncPosRejectedCounter = common.RegisterNewCounter("SyncPos Rejected")
+	syncPosStaleCounter = common.RegisterNewCounter("SyncPos Stale")
+
+	Register(RequestCode_SyncPos, States(server.GameState_Match), syncPos)
+}
+
+// syncPos queues the position update of a match player for the next tick of
+// its match, see liveMatch.apply.
+func syncPos(r *Request, m *request.SyncPos) error {
+	st, ok := playerState(m)
+	if !ok {
+		return ErrInvalidRequest
+	}
+
+	lm, i := sessionMatch(r.Session)
+	if lm == nil {
+		return ErrInvalidState
+	}
+
+	lm.queue(playerInput{session: r.Session, slot: i, state: st, ack: m.SnapshotAck})
+	return nil
+}
+
+// apply records the snapshot acknowledgement of a match player, sampling the
+// round-trip time of its session, and stores its position update as its latest
+// state, unless the update is older than the latest state or implies an
+// implausible movement.
+// It must be called with the match mutex held.
+//
+// Returns:
+//   - bool: False if the update has been rejected as implausible and the client
+//     should be sent back its latest accepted state, otherwise true.
+func (m *liveMatch) apply(in *playerInput) bool {
+	if int32(in.ack-m.acks[in.slot]) > 0 {
+		m.acks[in.slot] = in.ack
+		if b := &m.snapshots[in.ack%snapshotHistory]; b.sequence == in.ack {
+			in.session.ObserveRTT(in.state.Received.Sub(b.sent))
+		}
+	}
+
+	prev := &m.states[in.slot]
+	if !prev.Received.IsZero() {
+		if int32(in.state.Timestamp-prev.Timestamp) <= 0 {
+			syncPosStaleCounter.Increment()
+			return true
+		}
+		if !plausibleMove(prev, &in.state) {
+			syncPosRejectedCounter.Increment()
+			return false
+		}
+	}
+	*prev = in.state
+	m.observeClock(in.slot, &in.state, in.session.RTT())
+	syncPosAcceptedCounter.Increment()
+	return true
+}
+
+// playerState converts a position update into a player state received now. It
+// returns false if the update has no position or holds a non-finite value.
+func playerState(m *request.SyncPos) (PlayerState, bool) {
+	p, q, v := m.GetPosition(), m.GetRotation(), m.GetVelocity()
+	if p == nil {
+		return PlayerState{}, false
+	}
+
+	st := PlayerState{
+		Position:  [3]float32{p.X, p.Y, p.Z},
+		Rotation:  [4]float32{q.GetX(), q.GetY(), q.GetZ(), q.GetW()},
+		Velocity:  [3]float32{v.GetX(), v.GetY(), v.GetZ()},
+		Timestamp: m.Timestamp,
+		Received:  time.Now(),
+	}
+	for _, f := range [...]float32{
+		st.Position[0], st.Position[1], st.Position[2],
+		st.Rotation[0], st.Rotation[1], st.Rotation[2], st.Rotation[3],
+		st.Velocity[0], st.Velocity[1], st.Velocity[2],
+	} {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return PlayerState{}, false
+		}
+	}
+	return st, true
+}
+
+// plausibleMove reports whether a player may have moved from prev to next: the
+// reported velocity and the travelled distance over the elapsed time must not
+// exceed maxSpeed, and the distance must not exceed maxTeleport. The elapsed
+// time is the client interval, capped by the server observed interval.
+func plausibleMove(prev, next *PlayerState) bool {
+	if length(next.Velocity) > maxSpeed*speedTolerance {
+		return false
+	}
+
+	d := next.Position
+	for i := range d {
+		d[i] -= prev.Position[i]
+	}
+	dist := length(d)
+	if maxTeleport > 0 && dist > maxTeleport {
+		return false
+	}
+
+	elapsed := time.Duration(next.Timestamp-prev.Timestamp) * time.Millisecond
+	if observed := next.Received.Sub(prev.Received) + syncPosJitter; elapsed > observed {
+		elapsed = observed
+	}
+	return dist <= maxSpeed*float32(elapsed.Seconds())*speedTolerance+positionSlack
+}
+
+func length(v [3]float32) float32 {
+	return float32(math.Sqrt(float64(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])))
+}
+
+// syncPosResponse builds the SyncPos response of a player state.
+func syncPosResponse(sidx server.Handle, st *PlayerState) *response.SyncPos {
+	return &response.SyncPos{
+		Sidx:      uint32(sidx),
+		Position:  &protobuf.Vector3{X: st.Position[0], Y: st.Position[1], Z: st.Position[2]},
+		Rotation:  &protobuf.Quaternion{X: st.Rotation[0], Y: st.Rotation[1], Z: st.Rotation[2], W: st.Rotation[3]},
+		Velocity:  &protobuf.Vector3{X: st.Velocity[0], Y: st.Velocity[1], Z: st.Velocity[2]},
+		Timestamp: uint32(st.Received.UnixMilli()),
+	}
+}
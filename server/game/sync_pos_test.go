@@ -0,0 +1,82 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pemmel/gameserver/protobuf"
+	"github.com/pemmel/gameserver/server"
+	"github.com/pemmel/gameserver/server/game/request"
+)
+
+func newSyncPos(x float32, timestamp uint32) *request.SyncPos {
+	return &request.SyncPos{
+		Position:  &protobuf.Vector3{X: x},
+		Rotation:  &protobuf.Quaternion{W: 1},
+		Velocity:  &protobuf.Vector3{},
+		Timestamp: timestamp,
+	}
+}
+
+func newMatchSessions(t testing.TB) (MatchConfig, []*server.Session) {
+	var c MatchConfig
+	sessions := newTestSessions(t, mmTotalPlayerSize)
+	for i, s := range sessions {
+		c.PlayerConfigs[i].Sidx = s.Sidx
+	}
+	return c, sessions
+}
+
+func TestSyncPosValidation(t *testing.T) {
+	c, sessions := newMatchSessions(t)
+	lm := startMatch(c)
+	defer endMatch(lm.config.Id)
+
+	r := &Request{Session: sessions[3], Code: RequestCode_SyncPos}
+	syncPos := func(r *Request, m *request.SyncPos) error {
+		err := syncPos(r, m)
+		lm.step(time.Now())
+		return err
+	}
+	latest := func() PlayerState {
+		lm.mutex.Lock()
+		defer lm.mutex.Unlock()
+		return lm.states[3]
+	}
+
+	if err := syncPos(r, &request.SyncPos{Timestamp: 1}); err != ErrInvalidRequest {
+		t.Fatalf("expected invalid request without position, got %v", err)
+	}
+	if err := syncPos(r, newSyncPos(1, 1000)); err != nil || latest().Position[0] != 1 {
+		t.Fatalf("first update not stored: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		m    *request.SyncPos
+	}{
+		{"Stale", newSyncPos(1.1, 900)},
+		{"Teleport", newSyncPos(1+maxTeleport+1, 60000)},
+		{"Speed", newSyncPos(4, 1100)},
+	}
+	for _, tt := range tests {
+		if err := syncPos(r, tt.m); err != nil || latest().Position[0] != 1 {
+			t.Errorf("%s: update stored: %v", tt.name, err)
+		}
+	}
+
+	// The client clock claims a second elapsed, but the server observed less,
+	// so the claimed distance is capped by the observed interval.
+	time.Sleep(10 * time.Millisecond)
+	if err := syncPos(r, newSyncPos(1+maxSpeed/2, 2000)); err != nil || latest().Position[0] != 1 {
+		t.Errorf("update beyond the observed interval stored: %v", err)
+	}
+	if err := syncPos(r, newSyncPos(1.2, 2100)); err != nil || latest().Position[0] != 1.2 {
+		t.Errorf("plausible update not stored: %v", err)
+	}
+
+	endMatch(lm.config.Id)
+	if m, _ := sessionMatch(sessions[3]); m != nil || sessions[3].GameState != server.GameState_Idle {
+		t.Fatal("session still in the ended match")
+	}
+}
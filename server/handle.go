@@ -0,0 +1,45 @@
+package server
+
+// Handle identifies a session and is sent to the client as the SIDX of its packets.
+// It packs the session slot index with the generation of the slot, which changes
+// every time the slot is released. A handle minted for a previous occupant of a
+// reused slot therefore never resolves to the current occupant.
+//
+// - Layout (in bits, most significant first):
+//   - Generation: 12
+//   - Index: 20
+type Handle uint32
+
+const (
+	handleIndexBits      int    = 20
+	handleGenerationBits int    = 32 - handleIndexBits
+	handleIndexMask      uint32 = 1<<handleIndexBits - 1
+	handleGenerationMask uint32 = 1<<handleGenerationBits - 1
+
+	// MaxSessions is the maximum number of sessions a SessionContainer can hold,
+	// bounded by the number of slot indexes a Handle can represent.
+	MaxSessions int = 1 << handleIndexBits
+)
+
+// NewHandle creates a handle from the provided slot index and generation. Bits
+// beyond the width of each field are discarded.
+//
+// Parameters:
+//   - index: The session slot index.
+//   - generation: The generation of the session slot.
+//
+// Returns:
+//   - Handle: The packed session handle.
+func NewHandle(index uint32, generation uint32) Handle {
+	return Handle((generation&handleGenerationMask)<<handleIndexBits | index&handleIndexMask)
+}
+
+// Index returns the session slot index of the handle.
+func (h Handle) Index() uint32 {
+	return uint32(h) & handleIndexMask
+}
+
+// Generation returns the generation of the session slot of the handle.
+func (h Handle) Generation() uint32 {
+	return uint32(h) >> handleIndexBits
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"sync"
+)
+
+const (
+	replayWindowLen   int    = 1024
+	replayWordLen     int    = 64
+	replayWords       int    = replayWindowLen / replayWordLen
+	replayWindowMask  uint32 = uint32(replayWindowLen) - 1
+	replayWordBits    int    = 6
+	replayBitPosMask  uint32 = uint32(replayWordLen) - 1
+	replayMaxDistance int32  = int32(replayWindowLen)
+)
+
+// ReplayResult represents the outcome of checking a sequence number against a
+// ReplayWindow.
+type ReplayResult uint8
+
+const (
+	ReplayAccepted  ReplayResult = iota // sequence number has not been seen before
+	ReplayDuplicate                     // sequence number has already been accepted
+	ReplayStale                         // sequence number is older than the window
+)
+
+// ReplayWindow is a sliding window anti-replay filter for 32-bit packet sequence
+// numbers, in the manner of IPsec and DTLS. It remembers which of the last 1024
+// sequence numbers below the highest accepted one have been seen, accepting
+// reordered packets within the window while rejecting duplicates and packets
+// older than the window.
+//
+// Sequence numbers are compared with serial number arithmetic, so the window
+// keeps sliding forward when the sequence number wraps around. It is safe for
+// concurrent use by multiple packet workers.
+type ReplayWindow struct {
+	mutex  sync.Mutex
+	init   bool
+	top    uint32
+	bitmap [replayWords]uint64
+}
+
+// Accept checks the provided sequence number against the window and marks it as
+// seen if accepted. It must only be called for packets whose authenticity has
+// been verified, otherwise forged packets could slide the window forward.
+//
+// Parameters:
+//   - seq: The sequence number of the verified packet.
+//
+// Returns:
+//   - ReplayResult: ReplayAccepted if the sequence number is new, otherwise the
+//     reason of the rejection.
+func (w *ReplayWindow) Accept(seq uint32) ReplayResult {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.init {
+		w.init = true
+		w.top = seq
+		w.set(seq)
+		return ReplayAccepted
+	}
+
+	d := int32(seq - w.top)
+	switch {
+	case d > 0:
+		w.advance(seq, d)
+		w.top = seq
+		w.set(seq)
+		return ReplayAccepted
+	case -d >= replayMaxDistance || d == -1<<31:
+		return ReplayStale
+	case w.isSet(seq):
+		return ReplayDuplicate
+	default:
+		w.set(seq)
+		return ReplayAccepted
+	}
+}
+
+// advance clears the bits of the sequence numbers between the current top and
+// the provided new top, which are entering the window as not yet seen.
+func (w *ReplayWindow) advance(seq uint32, d int32) {
+	if d >= replayMaxDistance {
+		w.bitmap = [replayWords]uint64{}
+		return
+	}
+	for i := w.top + 1; i != seq+1; i++ {
+		w.clear(i)
+	}
+}
+
+func (w *ReplayWindow) set(seq uint32) {
+	pos := seq & replayWindowMask
+	w.bitmap[pos>>replayWordBits] |= 1 << (pos & replayBitPosMask)
+}
+
+func (w *ReplayWindow) clear(seq uint32) {
+	pos := seq & replayWindowMask
+	w.bitmap[pos>>replayWordBits] &^= 1 << (pos & replayBitPosMask)
+}
+
+func (w *ReplayWindow) isSet(seq uint32) bool {
+	pos := seq & replayWindowMask
+	return w.bitmap[pos>>replayWordBits]&(1<<(pos&replayBitPosMask)) != 0
+}
@@ -1,13 +1,19 @@
 package server
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"io"
+	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
 	"github.com/bytedance/gopkg/lang/fastrand"
+	"golang.org/x/crypto/hkdf"
 )
 
 // shared represents a shared instance of SessionContainer for managing sessions.
@@ -16,10 +22,29 @@ var shared *SessionContainer
 // init initializes the shared SessionContainer with a capacity of cap.
 func init() {
 	const cap int = 1e3
-	shared = &SessionContainer{
-		data:  make([]*Session, 0, cap),
-		empty: 0,
+	shared = NewSessionContainer(cap)
+}
+
+// NewSessionContainer creates an empty session container with the provided capacity.
+//
+// Parameters:
+//   - cap: The number of sessions the container can hold before growing.
+//
+// Returns:
+//   - *SessionContainer: A pointer to the new session container.
+func NewSessionContainer(cap int) *SessionContainer {
+	n := (cap + segmentLen - 1) / segmentLen
+	segments := make([]*segment, n)
+	for i := range segments {
+		segments[i] = new(segment)
+	}
+
+	c := &SessionContainer{
+		free: make([]uint32, 0),
+		uids: make(map[uint]Handle, cap),
 	}
+	c.segments.Store(&segments)
+	return c
 }
 
 // SharedSession returns the shared instance of SessionContainer.
@@ -45,16 +70,45 @@ func GenerateKey(b []byte) {
 	}
 }
 
+const (
+	segmentBits int = 12
+	segmentLen  int = 1 << segmentBits
+	segmentMask int = segmentLen - 1
+)
+
+// segment is a fixed-size block of session slots. Segments are never moved once
+// allocated, so a slot can be read without holding the container mutex. The slot
+// generations are only accessed while holding the container mutex.
+type segment struct {
+	sessions    [segmentLen]atomic.Pointer[Session]
+	generations [segmentLen]uint16
+}
+
 // SessionContainer represents a container for managing sessions.
+//
+// Sessions are stored in fixed-size segments referenced by a copy-on-write segment
+// directory, so Get never observes a slice being reallocated and takes no lock on
+// the packet verification path. Writers are serialized by the container mutex.
+// A reserved session index (sidx) stays nil until its session is registered, so
+// readers only ever observe nil or a fully initialized session.
+//
+// Released session indexes are kept in a free list and sessions are indexed by user
+// ID, so both allocation and user ID lookup take constant time. Every release bumps
+// the slot generation, so the Handle of a released session never resolves again.
 type SessionContainer struct {
-	mutex sync.Mutex
-	data  []*Session
-	empty int
+	mutex       sync.Mutex
+	segments    atomic.Pointer[[]*segment]
+	length      atomic.Uint32
+	free        []uint32
+	uids        map[uint]Handle
+	expireHooks []ExpireHook
+	resumeHooks []ResumeHook
 }
 
 // NewSession creates a new session with the provided NewSession function and
 // adds it to the session container only if able to reserve new session index
-// (sidx) and able to create a new session via NewSession function.
+// (sidx), able to create a new session via NewSession function, and no session
+// is registered for the user ID yet.
 //
 // Parameters:
 //   - new: A function for creating a new session.
@@ -62,73 +116,77 @@ type SessionContainer struct {
 //
 // Returns:
 //   - *Session: A pointer to the newly created session, or nil if the session
-//     index reservation fails or if the NewSession function fails
-//     to create a new instance.
+//     index reservation fails, if the NewSession function fails
+//     to create a new instance, or if the user ID already has a session.
 func (c *SessionContainer) NewSession(new NewSession, uid uint) *Session {
-	var sidx uint32
-	if !c.reserveSidx(&sidx) {
+	var idx uint32
+	if !c.reserveSidx(&idx) {
 		return nil
 	}
-	return c.register(new, uid, sidx)
+	return c.register(new, uid, idx)
 }
 
-// OutOfBounds checks if the provided session index is out of bounds, i.e., if it
-// exceeds the length of the session container's data slice.
+// OutOfBounds checks if the slot index of the provided handle is out of bounds,
+// i.e., if it has never been reserved by the session container.
 //
 // Parameters:
-//   - sidx: The session index to check.
+//   - h: The session handle to check.
 //
 // Returns:
 //   - bool: True if the session index is out of bounds, false otherwise..
-func (c *SessionContainer) OutOfBounds(sidx uint32) bool {
-	return int64(sidx) >= int64(len(c.data))
+func (c *SessionContainer) OutOfBounds(h Handle) bool {
+	return h.Index() >= c.length.Load()
 }
 
 // CanGrow checks if the session container can grow by comparing its current length
-// with the maximum value that Session.Sidx can represent.
+// with the maximum number of slot indexes that a Handle can represent.
 //
 // Returns:
 //   - bool: True if the session container can grow, false otherwise.
 func (c *SessionContainer) CanGrow() bool {
-	const max = ^uint32(0)
-	return int64(len(c.data)) < int64(max)
+	return int(c.length.Load()) < MaxSessions
 }
 
-// Remove removes a session from the session container at the specified index.
+// Remove removes the session identified by the provided handle from the session container.
 //
 // Parameters:
-//   - sidx: The session index of the session to remove.
+//   - h: The handle of the session to remove.
 //
 // Returns:
-//   - *Session: A pointer to the removed session, or nil if the session index
-//     is out of bounds.
-func (c *SessionContainer) Remove(sidx uint32) *Session {
-	if c.OutOfBounds(sidx) {
-		return nil
-	}
+//   - *Session: A pointer to the removed session, or nil if the handle does not
+//     identify a registered session.
+func (c *SessionContainer) Remove(h Handle) *Session {
 	c.mutex.Lock()
-	d := c.data[sidx]
-	if d != nil {
-		c.data[sidx] = nil
-		c.empty++
+	defer c.mutex.Unlock()
+
+	d := c.Get(h)
+	if d == nil {
+		return nil
 	}
-	c.mutex.Unlock()
+	c.release(d)
 	return d
 }
 
-// Get retrieves a session from the session container based on the provided session index.
+// Get retrieves a session from the session container based on the provided handle.
+// It is safe to call concurrently with any other method and takes no lock.
 //
 // Parameters:
-//   - sidx: The session index of the session to retrieve.
+//   - h: The handle of the session to retrieve.
 //
 // Returns:
-//   - *Session: A pointer to the session at the specified index, or nil if the index is
-//     out of bounds.
-func (c *SessionContainer) Get(sidx uint32) *Session {
-	if c.OutOfBounds(sidx) {
+//   - *Session: A pointer to the session identified by the handle, or nil if the
+//     index is out of bounds, holds no registered session, or holds a session of
+//     another generation.
+func (c *SessionContainer) Get(h Handle) *Session {
+	seg, i := c.locate(h.Index())
+	if seg == nil {
 		return nil
 	}
-	return c.data[sidx]
+	s := seg.sessions[i].Load()
+	if s == nil || s.Sidx != h {
+		return nil
+	}
+	return s
 }
 
 // GetFromUid retrieves a session from the session container based on the provided user ID.
@@ -140,113 +198,278 @@ func (c *SessionContainer) Get(sidx uint32) *Session {
 //   - *Session: A pointer to the session with the provided user ID, or nil if no session
 //     with the user ID is found.
 func (c *SessionContainer) GetFromUid(uid uint) *Session {
-	for _, d := range c.data {
-		if d != nil && d.Uid == uid {
-			return d
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	h, ok := c.uids[uid]
+	if !ok {
+		return nil
+	}
+	return c.Get(h)
+}
+
+// Range calls f for each session registered in the session container, without
+// holding the container mutex. Sessions registered or removed concurrently may or
+// may not be visited.
+//
+// Parameters:
+//   - f: The function called for each session, iteration stops if it returns false.
+func (c *SessionContainer) Range(f func(s *Session) bool) {
+	n := int(c.length.Load())
+	for _, seg := range *c.segments.Load() {
+		for i := 0; i < segmentLen && n > 0; i, n = i+1, n-1 {
+			if s := seg.sessions[i].Load(); s != nil && !f(s) {
+				return
+			}
 		}
 	}
-	return nil
 }
 
-// findEmptySpace searches the session container for an empty slot.
+// Resume replaces the provided session with a new session created by the provided
+// NewSession function under the same session handle (sidx), carrying over the game
+// state of the previous session. The previous session is no longer reachable from
+// the container, so packets sealed with its key are no longer accepted, and the
+// registered resume hooks are called so that the state kept for it is released.
+//
+// Parameters:
+//   - old: The session to be replaced, which must be currently registered.
+//   - new: A function for creating the new session.
 //
 // Returns:
-//   - int: The index of the first empty slot in the session container,
-//     or -1 if no empty slots are found.
-func (c *SessionContainer) findEmptySpace() int {
-	for i, d := range c.data {
-		if d == nil {
-			return i
-		}
+//   - *Session: A pointer to the new session, or nil if the old session is no
+//     longer registered or if the NewSession function fails to create a new
+//     instance.
+func (c *SessionContainer) Resume(old *Session, new NewSession) *Session {
+	s := new(old.Uid)
+	if s == nil {
+		return nil
+	}
+
+	c.mutex.Lock()
+	if c.Get(old.Sidx) != old {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	old.Mutex.Lock()
+	s.Sidx = old.Sidx
+	s.StateIdx = old.StateIdx
+	s.GameState = old.GameState
+	s.LoginTime = old.LoginTime
+	old.Mutex.Unlock()
+	s.SetRemoteAddr(old.RemoteAddr())
+
+	seg, i := c.locate(s.Sidx.Index())
+	seg.sessions[i].Store(s)
+	hooks := c.resumeHooks
+	c.mutex.Unlock()
+
+	for _, h := range hooks {
+		h(old, s)
 	}
-	return -1
+	return s
+}
+
+// ResumeHook is called after a session has been replaced by a resumed session
+// under the same session handle (sidx).
+type ResumeHook = func(old, s *Session)
+
+// OnResume registers a hook which is called after a session is resumed, so that
+// the resources associated with the previous session, e.g. its reliable channels,
+// can be released or carried over.
+//
+// Parameters:
+//   - h: The hook to register.
+func (c *SessionContainer) OnResume(h ResumeHook) {
+	c.mutex.Lock()
+	c.resumeHooks = append(c.resumeHooks, h)
+	c.mutex.Unlock()
+}
+
+// locate returns the segment holding the provided slot index along with the
+// position of the slot within the segment, or nil if the index is out of bounds.
+func (c *SessionContainer) locate(idx uint32) (*segment, int) {
+	if idx >= c.length.Load() {
+		return nil, 0
+	}
+	segments := *c.segments.Load()
+	return segments[int(idx)>>segmentBits], int(idx) & segmentMask
 }
 
 // register registers a new session in the session container at the specified index.
-// If the session creation using the provided NewSession function fails, a nil pointer
-// is stored in the container to mark the slot as empty. The session index and the
-// session itself are updated in the container upon successful registration.
+// If the session creation using the provided NewSession function fails, or if the user
+// ID already has a registered session, the index is released back to the free list. The session handle, made of the index and the
+// current slot generation, and the session itself are updated in the container and
+// the user ID index upon successful registration.
 //
 // Parameters:
 //   - new: A function for creating a new session.
 //   - uid: The user ID associated with the new session.
-//   - sidx: The index where the new session will be registered, obtained via the
-//     reserveSidx function to ensure a valid and available index.
+//   - idx: The slot index where the new session will be registered, obtained via
+//     the reserveSidx function to ensure a valid and available index.
 //
 // Returns:
 //   - *Session: A pointer to the newly registered session, which is identical to
-//     the session created by the NewSession function, which could be nil if the session
-//     creation fails.
-func (c *SessionContainer) register(new NewSession, uid uint, sidx uint32) *Session {
+//     the session created by the NewSession function, or nil if the session creation
+//     fails or if the user ID already has a session.
+func (c *SessionContainer) register(new NewSession, uid uint, idx uint32) *Session {
 	s := new(uid)
+	c.mutex.Lock()
+	if _, ok := c.uids[uid]; ok {
+		// Another session has been registered for the user ID since the caller
+		// looked it up, e.g. by a concurrent login.
+		s = nil
+	}
 	if s == nil {
-		c.data[sidx] = nil
-		c.mutex.Lock()
-		c.empty++
-		c.mutex.Unlock()
+		c.free = append(c.free, idx)
 	} else {
-		s.Sidx = sidx
-		c.data[sidx] = s
+		seg, i := c.locate(idx)
+		s.Sidx = NewHandle(idx, uint32(seg.generations[i]))
+		seg.sessions[i].Store(s)
+		c.uids[uid] = s.Sidx
 	}
+	c.mutex.Unlock()
 	return s
 }
 
+// release marks the slot of the provided registered session as empty, bumps the slot
+// generation, removes it from the user ID index and pushes the slot index to the free
+// list. The caller must hold the container mutex.
+func (c *SessionContainer) release(s *Session) {
+	idx := s.Sidx.Index()
+	seg, i := c.locate(idx)
+	seg.sessions[i].Store(nil)
+	seg.generations[i] = uint16((uint32(seg.generations[i]) + 1) & handleGenerationMask)
+	if h, ok := c.uids[s.Uid]; ok && h == s.Sidx {
+		delete(c.uids, s.Uid)
+	}
+	c.free = append(c.free, idx)
+}
+
 // reserveSidx reserves a session index for a new session in the session container.
-// Upon successful reservation, the provided sidx holds an index to the container
-// filled with a filler session. This ensures that sidx will not read as an empty
-// space, yet it's not a valid Session pointer.
+// A previously released index is popped from the free list if available, otherwise
+// the container grows by one slot, allocating a new segment when the last one is
+// full. The reserved slot stays nil until its session is registered, and it can not
+// be reserved again since it is neither in the free list nor beyond the length.
 //
 // Parameters:
-//   - sidx: A pointer to a uint32 variable where the reserved session index will be stored.
+//   - idx: A pointer to a uint32 variable where the reserved slot index will be stored.
 //
 // Returns:
 //   - bool: True if the session index was successfully reserved, false otherwise.
-func (c *SessionContainer) reserveSidx(sidx *uint32) bool {
+func (c *SessionContainer) reserveSidx(idx *uint32) bool {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	if c.empty != 0 {
-		*sidx = uint32(c.findEmptySpace())
-		c.data[*sidx] = c.filler()
-		c.empty--
+	if n := len(c.free); n != 0 {
+		*idx = c.free[n-1]
+		c.free = c.free[:n-1]
 		return true
 	}
 
-	if c.CanGrow() {
-		*sidx = uint32(len(c.data))
-		c.data = append(c.data, c.filler())
-		return true
+	if !c.CanGrow() {
+		return false
+	}
+
+	n := c.length.Load()
+	segments := *c.segments.Load()
+	if int(n)>>segmentBits >= len(segments) {
+		grown := make([]*segment, len(segments)+1)
+		copy(grown, segments)
+		grown[len(segments)] = new(segment)
+		c.segments.Store(&grown)
 	}
 
-	return false
+	*idx = n
+	c.length.Store(n + 1)
+	return true
+}
+
+type Session struct {
+	Version        uint8
+	Sidx           Handle
+	Uid            uint
+	StateIdx       int
+	GameState      int
+	LoginTime      time.Time
+	SharedKey      [32]byte
+	PublicKey      [32]byte // server X25519 public key, only used from version 2
+	ReconnectToken [32]byte
+	Mutex          sync.Mutex
+
+	keyMutex   sync.Mutex
+	current    atomic.Pointer[SessionKey]
+	previous   atomic.Pointer[SessionKey]
+	remoteAddr atomic.Pointer[net.UDPAddr]
+	lastSeen   atomic.Int64
+	rtt        atomic.Int64
+}
+
+// Touch records the current time as the time of the last valid packet sent by
+// the client of this session.
+func (s *Session) Touch() {
+	s.lastSeen.Store(time.Now().UnixNano())
+}
+
+// LastSeen returns the time of the last valid packet sent by the client of this
+// session, or the login time if no packet has been verified yet.
+func (s *Session) LastSeen() time.Time {
+	return time.Unix(0, s.lastSeen.Load())
+}
+
+// RemoteAddr returns the address of the last verified packet sent by the client
+// of this session, or nil if no packet has been verified yet.
+func (s *Session) RemoteAddr() *net.UDPAddr {
+	return s.remoteAddr.Load()
 }
 
-// filler returns a filler session for uninitialized session slots which value
-// is not a nil pointer. This ensures that the findEmptySpace function will not
-// assume it as an empty space when searching for available slots.
+// SetRemoteAddr records the address of a verified packet sent by the client of
+// this session, which is where packets to the client are sent to.
 //
 // Returns:
-//   - *Session: A filler session for uninitialized session slots.
+//   - bool: True if this is the first address recorded for the session, otherwise false.
+func (s *Session) SetRemoteAddr(addr *net.UDPAddr) bool {
+	return s.remoteAddr.Swap(addr) == nil
+}
+
+// RTT returns the smoothed round-trip time estimate of the client of this
+// session, or 0 if no sample has been observed yet.
+func (s *Session) RTT() time.Duration {
+	return time.Duration(s.rtt.Load())
+}
+
+// ObserveRTT folds a round-trip time sample into the session estimate, as an
+// exponentially weighted moving average with a weight of 1/8 per sample.
 //
-// #nosec
-func (c *SessionContainer) filler() *Session {
-	return (*Session)(unsafe.Pointer(uintptr(1)))
+// Parameters:
+//   - sample: The observed round-trip time.
+func (s *Session) ObserveRTT(sample time.Duration) {
+	if sample <= 0 {
+		return
+	}
+	for {
+		old := s.rtt.Load()
+		rtt := int64(sample)
+		if old != 0 {
+			rtt = old + (int64(sample)-old)/8
+		}
+		if s.rtt.CompareAndSwap(old, rtt) {
+			return
+		}
+	}
 }
 
-type Session struct {
-	Version   uint8
-	Sidx      uint32
-	Uid       uint
-	StateIdx  int
-	GameState int
-	LoginTime time.Time
-	SharedKey [32]byte
-	Cipher    cipher.AEAD
-	Mutex     sync.Mutex
+// VerifyReconnectToken reports whether the provided token matches the session
+// reconnect token, in constant time.
+func (s *Session) VerifyReconnectToken(token []byte) bool {
+	return subtle.ConstantTimeCompare(token, s.ReconnectToken[:]) == 1
 }
 
 type NewSession = func(uid uint) *Session
 
+// sessionKeyInfo is the HKDF info used to derive the version 2 session key.
+const sessionKeyInfo = "gameserver session v2 aes256gcm"
+
 // NewSessionV1 creates a new session with version 1 format, generating a unique
 // key and initializing the cipher for encryption. If any error occurs during
 // the key generation or cipher initialization, it returns nil.
@@ -260,24 +483,125 @@ type NewSession = func(uid uint) *Session
 func NewSessionV1(uid uint) *Session {
 	var sk [32]byte
 	GenerateKey(sk[:])
+	return newSession(1, uid, sk)
+}
 
-	cb, err := aes.NewCipher(sk[:])
+// NewSessionV2 returns a NewSession function which creates a new session with
+// version 2 format. Instead of generating a key to be sent to the client, the
+// session key is derived with HKDF-SHA256 from an X25519 key agreement between
+// an ephemeral server key pair and the provided client public key. The server
+// public key is stored in Session.PublicKey to be sent back to the client.
+//
+// Parameters:
+//   - peer: The client X25519 public key.
+//
+// Returns:
+//   - NewSession: A function creating the version 2 session, which returns nil
+//     if an error occurs during key agreement or cipher initialization.
+func NewSessionV2(peer *ecdh.PublicKey) NewSession {
+	return newSessionX25519(2, peer)
+}
+
+// NewSessionV3 returns a NewSession function which creates a new session with
+// version 3 format. The session key is agreed on as in version 2, version 3 only
+// differs in the packet nonce layout, which is prefixed with the sender role.
+//
+// Parameters:
+//   - peer: The client X25519 public key.
+//
+// Returns:
+//   - NewSession: A function creating the version 3 session, which returns nil
+//     if an error occurs during key agreement or cipher initialization.
+func NewSessionV3(peer *ecdh.PublicKey) NewSession {
+	return newSessionX25519(3, peer)
+}
+
+// newSessionX25519 returns a NewSession function which creates a new session with
+// the provided version, whose key is derived from an X25519 key agreement with
+// the provided client public key, see NewSessionV2.
+func newSessionX25519(version uint8, peer *ecdh.PublicKey) NewSession {
+	return func(uid uint) *Session {
+		priv, err := ecdh.X25519().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil
+		}
+
+		var pk [32]byte
+		copy(pk[:], priv.PublicKey().Bytes())
+
+		sk, err := DeriveSessionKey(priv, peer, priv.PublicKey())
+		if err != nil {
+			return nil
+		}
+
+		s := newSession(version, uid, sk)
+		if s != nil {
+			s.PublicKey = pk
+		}
+		return s
+	}
+}
+
+// DeriveSessionKey derives the version 2 session key with HKDF-SHA256 from the
+// X25519 key agreement between priv and the other party public key, salted with
+// the client public key followed by the server public key. The client and the
+// server derive the same key by passing their own private key.
+//
+// Parameters:
+//   - priv: The local X25519 private key, belonging to either client or server.
+//   - client: The client X25519 public key.
+//   - server: The server X25519 public key.
+//
+// Returns:
+//   - [32]byte: The derived AES-256 session key.
+//   - error: An error if the key agreement fails, otherwise nil.
+func DeriveSessionKey(priv *ecdh.PrivateKey, client, server *ecdh.PublicKey) ([32]byte, error) {
+	var sk [32]byte
+
+	peer := client
+	if priv.PublicKey().Equal(client) {
+		peer = server
+	}
+	secret, err := priv.ECDH(peer)
 	if err != nil {
+		return sk, err
+	}
+
+	salt := make([]byte, 0, 64)
+	salt = append(salt, client.Bytes()...)
+	salt = append(salt, server.Bytes()...)
+
+	r := hkdf.New(sha256.New, secret, salt, []byte(sessionKeyInfo))
+	if _, err := io.ReadFull(r, sk[:]); err != nil {
+		return sk, err
+	}
+	return sk, nil
+}
+
+// newSession creates a new session with the provided version and session key,
+// initializing the epoch 0 AES-256-GCM session key and generating a reconnect
+// token. It returns nil if the cipher initialization or token generation fails.
+func newSession(version uint8, uid uint, sk [32]byte) *Session {
+	key := newSessionKey(sk, 0)
+	if key == nil {
 		return nil
 	}
 
-	gcm, err := cipher.NewGCM(cb)
-	if err != nil {
+	var rt [32]byte
+	if _, err := rand.Read(rt[:]); err != nil {
 		return nil
 	}
 
-	return &Session{
-		Version:   1,
-		Uid:       uid,
-		StateIdx:  -1,
-		GameState: GameState_Idle,
-		SharedKey: sk,
-		Cipher:    gcm,
-		LoginTime: time.Now(),
+	s := &Session{
+		Version:        version,
+		Uid:            uid,
+		StateIdx:       -1,
+		GameState:      GameState_Idle,
+		SharedKey:      sk,
+		ReconnectToken: rt,
+		LoginTime:      time.Now(),
 	}
+	s.current.Store(key)
+	s.lastSeen.Store(s.LoginTime.UnixNano())
+	return s
 }
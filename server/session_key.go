@@ -0,0 +1,152 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// RekeyThreshold is the sequence number from which the session key should be rotated,
+// leaving enough sequence numbers for the rekey to complete before they run out.
+const RekeyThreshold uint32 = 1 << 31
+
+// rekeyInfo is the HKDF info prefix used to derive the session key of each epoch.
+const rekeyInfo = "gameserver session rekey"
+
+// SessionKey represents one epoch of the session AEAD key. Each epoch has its own
+// replay window and server send counter, as both sequence number spaces restart
+// with a new key. Epoch 0 uses the session SharedKey, subsequent epochs derive
+// their key from SharedKey via HKDF.
+type SessionKey struct {
+	Epoch  uint32
+	Cipher cipher.AEAD
+	Replay ReplayWindow
+
+	send     atomic.Uint32
+	expiry   atomic.Int64
+	notified atomic.Int64
+}
+
+// newSessionKey creates the session key of the provided epoch from the root key.
+//
+// Parameters:
+//   - root: The session SharedKey.
+//   - epoch: The epoch of the key.
+//
+// Returns:
+//   - *SessionKey: The session key, or nil if the key derivation or cipher
+//     initialization fails.
+func newSessionKey(root [32]byte, epoch uint32) *SessionKey {
+	key := root
+	if epoch != 0 {
+		info := binary.BigEndian.AppendUint32([]byte(rekeyInfo), epoch)
+		r := hkdf.New(sha256.New, root[:], nil, info)
+		if _, err := io.ReadFull(r, key[:]); err != nil {
+			return nil
+		}
+	}
+
+	cb, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil
+	}
+
+	gcm, err := cipher.NewGCM(cb)
+	if err != nil {
+		return nil
+	}
+
+	return &SessionKey{Epoch: epoch, Cipher: gcm}
+}
+
+// NextSequence returns the next sequence number for a packet sent by the server
+// under this key. Sequence numbers are never reused, as reusing one would reuse
+// the AEAD nonce.
+//
+// Returns:
+//   - uint32: The sequence number.
+//   - bool: True if a sequence number is available, false if they are exhausted
+//     and the key must no longer be used for sending.
+func (k *SessionKey) NextSequence() (uint32, bool) {
+	for {
+		seq := k.send.Load()
+		if seq == ^uint32(0) {
+			return 0, false
+		}
+		if k.send.CompareAndSwap(seq, seq+1) {
+			return seq + 1, true
+		}
+	}
+}
+
+// NotifyDue reports whether a notification about this key is due, allowing at
+// most one notification per interval across concurrent callers.
+//
+// Parameters:
+//   - interval: The minimum interval between two notifications.
+//
+// Returns:
+//   - bool: True if the caller should send the notification, otherwise false.
+func (k *SessionKey) NotifyDue(interval time.Duration) bool {
+	now := time.Now().UnixNano()
+	last := k.notified.Load()
+	return now-last >= int64(interval) && k.notified.CompareAndSwap(last, now)
+}
+
+// SequenceSent returns the last sequence number sent by the server under this key.
+func (k *SessionKey) SequenceSent() uint32 {
+	return k.send.Load()
+}
+
+// Key returns the current session key, used to send packets and to verify the
+// packets of the client.
+func (s *Session) Key() *SessionKey {
+	return s.current.Load()
+}
+
+// PreviousKey returns the session key superseded by the last rekey, only while
+// its overlap has not elapsed, otherwise nil. Packets sealed by the client with
+// the previous key are accepted during the overlap, as the client may not have
+// received the rekey notification yet.
+func (s *Session) PreviousKey() *SessionKey {
+	k := s.previous.Load()
+	if k == nil || time.Now().UnixNano() > k.expiry.Load() {
+		return nil
+	}
+	return k
+}
+
+// Rekey rotates the session key to the next epoch, only if the provided key is
+// still the current key, so concurrent workers trigger a single rekey.
+//
+// Parameters:
+//   - from: The key observed as current by the caller.
+//   - overlap: How long the superseded key remains accepted.
+//
+// Returns:
+//   - *SessionKey: The new current key, or nil if the key has already been
+//     rotated or the key derivation fails.
+func (s *Session) Rekey(from *SessionKey, overlap time.Duration) *SessionKey {
+	s.keyMutex.Lock()
+	defer s.keyMutex.Unlock()
+
+	if s.current.Load() != from {
+		return nil
+	}
+
+	next := newSessionKey(s.SharedKey, from.Epoch+1)
+	if next == nil {
+		return nil
+	}
+
+	from.expiry.Store(time.Now().Add(overlap).UnixNano())
+	s.previous.Store(from)
+	s.current.Store(next)
+	return next
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"time"
+)
+
+// ReaperConfig represents the configuration of the session reaper.
+type ReaperConfig struct {
+	Interval    time.Duration // interval between scans of the session container
+	IdleTimeout time.Duration // expire sessions without a valid packet for this long, 0 disables
+	MaxLifetime time.Duration // expire sessions logged in for this long, 0 disables
+	OnExpire    ExpireHook    // called after the expire hooks for a session expired by the reaper
+}
+
+// ExpireHook is called after a session has been removed from the session container
+// because it expired or was terminated by the server.
+type ExpireHook = func(s *Session)
+
+// OnExpire registers a hook which is called after a session expires, so that the
+// resources associated with the session, e.g. lobby, queue or match membership,
+// can be released.
+//
+// Parameters:
+//   - h: The hook to register.
+func (c *SessionContainer) OnExpire(h ExpireHook) {
+	c.mutex.Lock()
+	c.expireHooks = append(c.expireHooks, h)
+	c.mutex.Unlock()
+}
+
+// Expire removes the provided session from the session container and calls the
+// registered expire hooks, only if the session is still registered.
+//
+// Parameters:
+//   - s: The session to expire.
+//
+// Returns:
+//   - bool: True if the session has been expired, false if it was no longer registered.
+func (c *SessionContainer) Expire(s *Session) bool {
+	c.mutex.Lock()
+	if c.Get(s.Sidx) != s {
+		c.mutex.Unlock()
+		return false
+	}
+	c.release(s)
+	hooks := c.expireHooks
+	c.mutex.Unlock()
+
+	for _, h := range hooks {
+		h(s)
+	}
+	return true
+}
+
+// RunReaper spawns a goroutine which periodically expires sessions which have not
+// sent a valid packet within the idle timeout, or which have exceeded the maximum
+// lifetime since login, until the provided context is done.
+//
+// Parameters:
+//   - ctx: The context which stops the reaper when done.
+//   - rc: The configuration of the session reaper.
+func (c *SessionContainer) RunReaper(ctx context.Context, rc ReaperConfig) {
+	go func() {
+		t := time.NewTicker(rc.Interval)
+		defer t.Stop()
+		var b []*Session
+		for {
+			var now time.Time
+			select {
+			case <-ctx.Done():
+				return
+			case now = <-t.C:
+			}
+			b = c.collectExpired(now, rc, b[:0])
+			for i, s := range b {
+				if c.Expire(s) && rc.OnExpire != nil {
+					rc.OnExpire(s)
+				}
+				b[i] = nil
+			}
+		}
+	}()
+}
+
+// collectExpired appends the sessions which are expired at the provided time to b.
+func (c *SessionContainer) collectExpired(now time.Time, rc ReaperConfig, b []*Session) []*Session {
+	idle := now.Add(-rc.IdleTimeout)
+	life := now.Add(-rc.MaxLifetime)
+
+	c.Range(func(s *Session) bool {
+		if rc.IdleTimeout > 0 && s.LastSeen().Before(idle) ||
+			rc.MaxLifetime > 0 && s.LoginTime.Before(life) {
+			b = append(b, s)
+		}
+		return true
+	})
+	return b
+}
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pemmel/gameserver/server"
+)
+
+func TestReplayWindowReordering(t *testing.T) {
+	var w server.ReplayWindow
+	for _, seq := range []uint32{10, 12, 11, 15, 13, 14, 9} {
+		if r := w.Accept(seq); r != server.ReplayAccepted {
+			t.Fatalf("seq %d: expected accepted, got %d", seq, r)
+		}
+	}
+}
+
+func TestReplayWindowDuplicate(t *testing.T) {
+	var w server.ReplayWindow
+	for _, seq := range []uint32{100, 101, 99} {
+		w.Accept(seq)
+	}
+	for _, seq := range []uint32{100, 101, 99} {
+		if r := w.Accept(seq); r != server.ReplayDuplicate {
+			t.Fatalf("seq %d: expected duplicate, got %d", seq, r)
+		}
+	}
+}
+
+func TestReplayWindowStale(t *testing.T) {
+	var w server.ReplayWindow
+	w.Accept(5000)
+
+	if r := w.Accept(5000 - 1023); r != server.ReplayAccepted {
+		t.Fatalf("expected oldest sequence within window to be accepted, got %d", r)
+	}
+	if r := w.Accept(5000 - 1024); r != server.ReplayStale {
+		t.Fatalf("expected sequence beyond window to be stale, got %d", r)
+	}
+
+	// Sliding forward forgets sequence numbers leaving the window, and the
+	// positions they occupied must be reusable by the new sequence numbers.
+	w.Accept(5000 + 1024)
+	if r := w.Accept(5000 + 1); r != server.ReplayAccepted {
+		t.Fatalf("expected sequence within slid window to be accepted, got %d", r)
+	}
+	if r := w.Accept(5000); r != server.ReplayStale {
+		t.Fatalf("expected old top to be stale, got %d", r)
+	}
+}
+
+func TestReplayWindowWraparound(t *testing.T) {
+	var w server.ReplayWindow
+	const top = ^uint32(0)
+
+	for _, seq := range []uint32{top - 2, top, 0, 1, top - 1} {
+		if r := w.Accept(seq); r != server.ReplayAccepted {
+			t.Fatalf("seq %d: expected accepted, got %d", seq, r)
+		}
+	}
+	for _, seq := range []uint32{top, 0, top - 2} {
+		if r := w.Accept(seq); r != server.ReplayDuplicate {
+			t.Fatalf("seq %d: expected duplicate, got %d", seq, r)
+		}
+	}
+	if r := w.Accept(top - 1100); r != server.ReplayStale {
+		t.Fatalf("expected pre-wrap sequence beyond window to be stale, got %d", r)
+	}
+}
+
+func TestReplayWindowConcurrent(t *testing.T) {
+	const (
+		nbWorkers = 8
+		nbPackets = 1000
+	)
+
+	// Every sequence number is delivered twice by concurrent workers, exactly
+	// one delivery of each must be accepted.
+	var w server.ReplayWindow
+	var accepted atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < nbWorkers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for seq := uint32(i % 2); seq < nbPackets; seq++ {
+				if w.Accept(seq/2) == server.ReplayAccepted {
+					accepted.Add(1)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if n := accepted.Load(); n != nbPackets/2 {
+		t.Fatalf("expected %d accepted packets, got %d", nbPackets/2, n)
+	}
+}
@@ -1,8 +1,13 @@
 package test
 
 import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pemmel/gameserver/server"
 )
@@ -20,10 +25,260 @@ func BenchmarkGenerateClientKey(b *testing.B) {
 	}
 }
 
-func BenchmarkNewSession(b *testing.B) {
-	b.Run("V1", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_ = server.SharedSession().NewSession(server.NewSessionV1, uint(i))
+func TestNewSessionV2KeyAgreement(t *testing.T) {
+	priv, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	c := server.NewSessionContainer(1)
+	s := c.NewSession(server.NewSessionV2(priv.PublicKey()), 1)
+	if s == nil || s.Version != 2 {
+		t.Fatal("failed to create version 2 session")
+	}
+
+	pub, err := ecdh.X25519().NewPublicKey(s.PublicKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, err := server.DeriveSessionKey(priv, priv.PublicKey(), pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sk != s.SharedKey {
+		t.Fatal("client and server derived different session keys")
+	}
+}
+
+func TestReaperExpiresIdleSession(t *testing.T) {
+	c := server.NewSessionContainer(2)
+	expired := make(chan *server.Session, 2)
+	c.OnExpire(func(s *server.Session) { expired <- s })
+
+	idle := c.NewSession(server.NewSessionV1, 100)
+	active := c.NewSession(server.NewSessionV1, 101)
+
+	reaped := make(chan *server.Session, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.RunReaper(ctx, server.ReaperConfig{
+		Interval:    10 * time.Millisecond,
+		IdleTimeout: 50 * time.Millisecond,
+		OnExpire:    func(s *server.Session) { reaped <- s },
+	})
+
+	deadline := time.After(time.Second)
+	for {
+		active.Touch()
+		select {
+		case s := <-expired:
+			if s == active {
+				t.Fatal("active session expired")
+			}
+			if s == idle {
+				if c.Get(idle.Sidx) != nil {
+					t.Fatal("expired session is still registered")
+				}
+				if <-reaped != idle {
+					t.Fatal("reaper expire hook not called for the idle session")
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatal("idle session did not expire")
+		case <-time.After(5 * time.Millisecond):
 		}
+	}
+}
+
+func TestSessionHandleGeneration(t *testing.T) {
+	c := server.NewSessionContainer(1)
+
+	old := c.NewSession(newBareSession, 1)
+	c.Remove(old.Sidx)
+	s := c.NewSession(newBareSession, 2)
+
+	if s.Sidx.Index() != old.Sidx.Index() {
+		t.Fatal("expected released slot to be reused")
+	}
+	if s.Sidx == old.Sidx {
+		t.Fatal("expected reused slot to have a new generation")
+	}
+	if c.Get(old.Sidx) != nil || c.Remove(old.Sidx) != nil {
+		t.Fatal("stale handle resolved to the new occupant")
+	}
+	if c.Get(s.Sidx) != s {
+		t.Fatal("current handle did not resolve")
+	}
+}
+
+func BenchmarkNewSession(b *testing.B) {
+	priv, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	tests := []struct {
+		name string
+		new  server.NewSession
+	}{
+		{"V1", server.NewSessionV1},
+		{"V2", server.NewSessionV2(priv.PublicKey())},
+	}
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			// A full container is replaced outside of the timer, so that only
+			// successful allocations are measured.
+			n := min(b.N, server.MaxSessions)
+			c := server.NewSessionContainer(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if i > 0 && i%n == 0 {
+					b.StopTimer()
+					c = server.NewSessionContainer(n)
+					b.StartTimer()
+				}
+				if c.NewSession(tt.new, uint(i%n)) == nil {
+					b.Fatal("failed to create session")
+				}
+			}
+		})
+	}
+}
+
+// newBareSession creates a session without key material, so that containers with
+// millions of sessions can be filled quickly.
+func newBareSession(uid uint) *server.Session {
+	return &server.Session{Uid: uid}
+}
+
+// newFragmentedContainer creates a container holding n sessions, then removes every
+// other session so that allocations are served from released session indexes.
+func newFragmentedContainer(n int) *server.SessionContainer {
+	c := server.NewSessionContainer(n)
+	s := make([]*server.Session, n)
+	for i := 0; i < n; i++ {
+		s[i] = c.NewSession(newBareSession, uint(i))
+	}
+	for i := 0; i < n; i += 2 {
+		c.Remove(s[i].Sidx)
+	}
+	return c
+}
+
+func BenchmarkSessionAllocation(b *testing.B) {
+	tests := []int{1e3, 1e4, 1e5, 1e6}
+
+	for _, n := range tests {
+		c := newFragmentedContainer(n)
+		b.Run(fmt.Sprintf("Sessions-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s := c.NewSession(newBareSession, uint(n+i))
+				c.Remove(s.Sidx)
+			}
+		})
+	}
+}
+
+func BenchmarkSessionGetFromUid(b *testing.B) {
+	tests := []int{1e3, 1e4, 1e5, 1e6}
+
+	for _, n := range tests {
+		c := newFragmentedContainer(n)
+		b.Run(fmt.Sprintf("Sessions-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = c.GetFromUid(uint(i % n))
+			}
+		})
+	}
+}
+
+func TestSessionContainerConcurrent(t *testing.T) {
+	const (
+		nbWriters = 8
+		nbReaders = 8
+		nbRounds  = 2000
+	)
+
+	c := server.NewSessionContainer(16)
+	done := make(chan struct{})
+	var writers, readers sync.WaitGroup
+
+	for w := 0; w < nbWriters; w++ {
+		writers.Add(1)
+		go func(w int) {
+			defer writers.Done()
+			for i := 0; i < nbRounds; i++ {
+				uid := uint(w*nbRounds + i)
+				s := c.NewSession(newBareSession, uid)
+				if s == nil {
+					t.Error("failed to create session")
+					return
+				}
+				if got := c.GetFromUid(uid); got != s {
+					t.Error("uid index does not point to the registered session")
+					return
+				}
+				if i%3 != 0 {
+					c.Remove(s.Sidx)
+				}
+			}
+		}(w)
+	}
+
+	for r := 0; r < nbReaders; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for i := uint32(0); ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				// Every session observed must be fully registered.
+				h := server.Handle(i % (nbWriters * nbRounds))
+				if s := c.Get(h); s != nil && s.Sidx != h {
+					t.Error("observed session with mismatching sidx")
+					return
+				}
+			}
+		}()
+	}
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	n := 0
+	c.Range(func(s *server.Session) bool {
+		n++
+		return true
 	})
+	if want := nbWriters * ((nbRounds + 2) / 3); n != want {
+		t.Fatalf("expected %d sessions, got %d", want, n)
+	}
+}
+
+func TestNewSessionSameUidConcurrent(t *testing.T) {
+	const nbLogins = 16
+
+	c := server.NewSessionContainer(nbLogins)
+	sessions := make(chan *server.Session, nbLogins)
+	var wg sync.WaitGroup
+	for i := 0; i < nbLogins; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if s := c.NewSession(newBareSession, 1); s != nil {
+				sessions <- s
+			}
+		}()
+	}
+	wg.Wait()
+	close(sessions)
+
+	// Only one session is registered for the user ID, and it is the one indexed.
+	s, ok := <-sessions
+	if !ok {
+		t.Fatal("no session created")
+	}
+	if _, ok := <-sessions; ok {
+		t.Fatal("several sessions created for the same uid")
+	}
+	if c.GetFromUid(1) != s {
+		t.Fatal("uid index does not point to the registered session")
+	}
 }